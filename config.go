@@ -1,26 +1,107 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 )
 
+// ForgeConfig describes a single configured forge connection: which kind it
+// is, where it lives, and how to authenticate against it.
+type ForgeConfig struct {
+	Type     string `json:"type"`
+	BaseURL  string `json:"base_url"`
+	Token    string `json:"token"`
+	Username string `json:"username"`
+
+	// Alias uniquely identifies this forge connection when Type alone
+	// doesn't — e.g. two GitLab entries, one for gitlab.com and one for a
+	// self-hosted instance, or two GitHub accounts. It becomes the
+	// forge's Forge.ID() and the prefix repo choices round-trip through;
+	// left empty, ID() falls back to "<type>@<base_url>", which is only
+	// unique as long as no two entries of the same type share a base URL.
+	Alias string `json:"alias"`
+
+	// PerPage and MaxPages bound how aggressively the forge paginates
+	// through repos/commits. Zero means "use the forge's own default".
+	PerPage  int `json:"per_page"`
+	MaxPages int `json:"max_pages"`
+}
+
 type Config struct {
-	GitHubToken string
-	GeminiKey   string
-	Username    string
-	DaysBack    int
-	PerPage     int
+	Forges []ForgeConfig
+
+	// LLMProvider selects which backend NewLLM builds: "gemini" (default),
+	// "openai", "anthropic", or "ollama". LLMModel overrides that
+	// provider's default model.
+	LLMProvider   string
+	LLMModel      string
+	GeminiKey     string
+	OpenAIKey     string
+	AnthropicKey  string
+	OllamaBaseURL string
+
+	// PromptTemplatePath, if set, overrides the built-in prompt template
+	// (templates/standup.tmpl) so users can customize the standup's tone
+	// and framing without recompiling.
+	PromptTemplatePath string
+
+	DaysBack int
+	PerPage  int
+
+	// Concurrency bounds how many repo choices GetActivitySince fetches at
+	// once. Zero uses defaultConcurrency.
+	Concurrency int
+}
+
+// loadForgeConfigs builds the list of configured forges. If FORGES_CONFIG
+// points at a JSON file (an array of ForgeConfig), that takes precedence so
+// users can configure GitLab/Gitea/Gerrit alongside GitHub. Otherwise it
+// falls back to the single GitHub forge built from the legacy env vars,
+// so existing setups keep working unchanged.
+func loadForgeConfigs() []ForgeConfig {
+	if path := os.Getenv("FORGES_CONFIG"); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var forges []ForgeConfig
+			if err := json.Unmarshal(data, &forges); err == nil {
+				return forges
+			}
+		}
+	}
+
+	token := os.Getenv("GITHUB_ACCESS_TOKEN")
+	username := os.Getenv("GITHUB_USERNAME")
+	if token == "" && username == "" {
+		return nil
+	}
+
+	perPage, _ := strconv.Atoi(os.Getenv("GITHUB_FETCH_PER_PAGE"))
+	maxPages, _ := strconv.Atoi(os.Getenv("GITHUB_FETCH_MAX_PAGES"))
+
+	return []ForgeConfig{
+		{
+			Type:     "github",
+			Token:    token,
+			Username: username,
+			PerPage:  perPage,
+			MaxPages: maxPages,
+		},
+	}
 }
 
 func LoadConfig() *Config {
 	config := &Config{
-		GitHubToken: os.Getenv("GITHUB_ACCESS_TOKEN"),
-		GeminiKey:   os.Getenv("GEMINI_API_KEY"),
-		Username:    os.Getenv("GITHUB_USERNAME"),
-		DaysBack:    7,  // Default to last 7 days
-		PerPage:     10, // Default items per page
+		Forges:             loadForgeConfigs(),
+		LLMProvider:        os.Getenv("LLM_PROVIDER"),
+		LLMModel:           os.Getenv("LLM_MODEL"),
+		GeminiKey:          os.Getenv("GEMINI_API_KEY"),
+		OpenAIKey:          os.Getenv("OPENAI_API_KEY"),
+		AnthropicKey:       os.Getenv("ANTHROPIC_API_KEY"),
+		OllamaBaseURL:      os.Getenv("OLLAMA_BASE_URL"),
+		PromptTemplatePath: os.Getenv("PROMPT_TEMPLATE_PATH"),
+		DaysBack:           7,  // Default to last 7 days
+		PerPage:            10, // Default items per page
 	}
 
 	// Override defaults with environment variables if provided
@@ -36,20 +117,47 @@ func LoadConfig() *Config {
 		}
 	}
 
+	if concurrencyStr := os.Getenv("FETCH_CONCURRENCY"); concurrencyStr != "" {
+		if concurrency, err := strconv.Atoi(concurrencyStr); err == nil && concurrency > 0 {
+			config.Concurrency = concurrency
+		}
+	}
+
 	return config
 }
 
 func (c *Config) Validate() error {
-	if c.GitHubToken == "" {
-		return fmt.Errorf("GITHUB_ACCESS_TOKEN environment variable is required")
+	if len(c.Forges) == 0 {
+		return fmt.Errorf("at least one forge must be configured (set FORGES_CONFIG or GITHUB_ACCESS_TOKEN/GITHUB_USERNAME)")
 	}
 
-	if c.GeminiKey == "" {
-		return fmt.Errorf("GEMINI_API_KEY environment variable is required")
+	for _, f := range c.Forges {
+		if f.Username == "" {
+			return fmt.Errorf("forge %q is missing a username", f.Type)
+		}
+		if f.Token == "" {
+			return fmt.Errorf("forge %q is missing a token", f.Type)
+		}
 	}
 
-	if c.Username == "" {
-		return fmt.Errorf("GITHUB_USERNAME environment variable is required")
+	switch c.LLMProvider {
+	case "gemini", "":
+		if c.GeminiKey == "" {
+			return fmt.Errorf("GEMINI_API_KEY environment variable is required")
+		}
+	case "openai":
+		if c.OpenAIKey == "" {
+			return fmt.Errorf("OPENAI_API_KEY environment variable is required")
+		}
+	case "anthropic":
+		if c.AnthropicKey == "" {
+			return fmt.Errorf("ANTHROPIC_API_KEY environment variable is required")
+		}
+	case "ollama":
+		// Ollama runs locally with no API key; OllamaBaseURL defaults to
+		// localhost if unset.
+	default:
+		return fmt.Errorf("unknown LLM_PROVIDER %q", c.LLMProvider)
 	}
 
 	return nil
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// standupFilename returns the base filename (without extension) shared by
+// every file export, e.g. "standup-2026-07-27".
+func standupFilename() string {
+	return fmt.Sprintf("standup-%s", time.Now().Format("2006-01-02"))
+}
+
+// ExportMarkdown renders the summary plus per-repo commit sections (linking
+// each commit via its HTMLURL) to ./standup-YYYY-MM-DD.md, returning the
+// path written.
+func ExportMarkdown(activity Activity, summary string) (string, error) {
+	var md strings.Builder
+	md.WriteString("# Daily Standup\n\n")
+	md.WriteString(summary)
+	md.WriteString("\n\n## Commits\n\n")
+
+	repoCommits := make(map[string][]Commit)
+	var repoOrder []string
+	for _, c := range activity.Commits {
+		if _, ok := repoCommits[c.RepoName]; !ok {
+			repoOrder = append(repoOrder, c.RepoName)
+		}
+		repoCommits[c.RepoName] = append(repoCommits[c.RepoName], c)
+	}
+
+	for _, repoName := range repoOrder {
+		md.WriteString(fmt.Sprintf("### %s\n\n", repoName))
+		for _, c := range repoCommits[repoName] {
+			md.WriteString(fmt.Sprintf("- [%s](%s): %s\n", c.SHA, c.HTMLURL, c.Message))
+		}
+		md.WriteString("\n")
+	}
+
+	path := standupFilename() + ".md"
+	if err := os.WriteFile(path, []byte(md.String()), 0o644); err != nil {
+		return "", fmt.Errorf("export: failed to write markdown: %w", err)
+	}
+
+	return path, nil
+}
+
+// exportedActivity is the shape written by ExportJSON: the LLM prose
+// alongside the structured activity data, so downstream automation can
+// consume commits/issues/PRs/reviews directly instead of parsing prose.
+type exportedActivity struct {
+	Summary  string   `json:"summary"`
+	Activity Activity `json:"activity"`
+}
+
+// ExportJSON writes the summary plus the structured activity data to
+// ./standup-YYYY-MM-DD.json, returning the path written.
+func ExportJSON(activity Activity, summary string) (string, error) {
+	data, err := json.MarshalIndent(exportedActivity{Summary: summary, Activity: activity}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("export: failed to marshal json: %w", err)
+	}
+
+	path := standupFilename() + ".json"
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("export: failed to write json: %w", err)
+	}
+
+	return path, nil
+}
+
+// Minimal Slack Block Kit types, just enough for a header and a markdown
+// section block.
+type slackTextObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Type string           `json:"type"`
+	Text *slackTextObject `json:"text,omitempty"`
+}
+
+type slackMessage struct {
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// ExportSlackBlocks renders the summary as Slack Block Kit JSON suitable for
+// chat.postMessage's blocks field, writing it to
+// ./standup-YYYY-MM-DD.slack.json and returning the path written.
+func ExportSlackBlocks(summary string) (string, error) {
+	msg := slackMessage{
+		Blocks: []slackBlock{
+			{Type: "header", Text: &slackTextObject{Type: "plain_text", Text: "Daily Standup"}},
+			{Type: "section", Text: &slackTextObject{Type: "mrkdwn", Text: summary}},
+		},
+	}
+
+	data, err := json.MarshalIndent(msg, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("export: failed to marshal slack blocks: %w", err)
+	}
+
+	path := standupFilename() + ".slack.json"
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("export: failed to write slack blocks: %w", err)
+	}
+
+	return path, nil
+}
+
+// CopyToClipboard copies text to the system clipboard by shelling out to the
+// platform's clipboard utility (pbcopy on macOS, clip on Windows, xclip or
+// xsel on Linux) rather than pulling in a cross-platform clipboard
+// dependency.
+func CopyToClipboard(text string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("pbcopy")
+	case "windows":
+		cmd = exec.Command("clip")
+	default:
+		if _, err := exec.LookPath("xclip"); err == nil {
+			cmd = exec.Command("xclip", "-selection", "clipboard")
+		} else {
+			cmd = exec.Command("xsel", "--clipboard", "--input")
+		}
+	}
+
+	cmd.Stdin = strings.NewReader(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("export: failed to copy to clipboard: %w", err)
+	}
+
+	return nil
+}
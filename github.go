@@ -1,200 +1,346 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
-)
-
-const githubAPIBaseURL = "https://api.github.com"
-
-type Repo struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Private   bool      `json:"private"`
-	HTMLURL   string    `json:"html_url"`
-	URL       string    `json:"url"`
-	Language  string    `json:"language"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
-
-func (r Repo) String() string {
-	return fmt.Sprintf("%s (%s) - Updated: %s", r.Name, r.Language, r.UpdatedAt.Format("2006-01-02"))
-}
 
-type user struct {
-	username string
-	apiKey   string
-}
-
-type GitHub struct {
-	user  user
-	repos []Repo
-}
+	"github.com/RafaZeero/daily-go/internal/forge"
+)
 
-type GitHubOptions struct {
-	APIKey   string
-	Username string
+// defaultConcurrency bounds how many repo choices GetActivitySince fetches
+// at once when the configured Concurrency is unset.
+const defaultConcurrency = 4
+
+// Forges aggregates repo/commit listing across every forge the user has
+// configured (GitHub, GitLab, Gitea, Gerrit, ...). It replaces the old
+// single-forge GitHub type.
+type Forges struct {
+	forges      []forge.Forge
+	progress    chan forge.Page
+	concurrency int
 }
 
-func NewGithub(opts GitHubOptions) *GitHub {
-	if opts.APIKey == "" {
-		log.Fatal("authorization token should not be empty")
+// NewForges builds a Forge client for each configured entry and fails fast
+// if any of them can't be constructed, mirroring the old NewGithub behavior
+// of refusing to start with a bad configuration. Any forge that supports
+// paginated-fetch progress reporting has its events routed to Progress().
+// concurrency bounds how many repo choices GetActivitySince fetches at
+// once; zero or negative uses defaultConcurrency.
+func NewForges(configs []ForgeConfig, concurrency int) (*Forges, error) {
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("at least one forge must be configured")
 	}
 
-	if opts.Username == "" {
-		log.Fatal("user should not be empty")
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
 	}
 
-	gh := &GitHub{
-		user: user{
-			username: opts.Username,
-			apiKey:   opts.APIKey,
-		},
-		repos: make([]Repo, 0),
-	}
-
-	gh.LoadReposFromUser()
-
-	return gh
-}
+	f := &Forges{progress: make(chan forge.Page, 16), concurrency: concurrency}
+
+	forges := make([]forge.Forge, 0, len(configs))
+	for _, cfg := range configs {
+		fg, err := forge.New(forge.Options{
+			Kind:     forge.Kind(cfg.Type),
+			BaseURL:  cfg.BaseURL,
+			Token:    cfg.Token,
+			Username: cfg.Username,
+			Alias:    cfg.Alias,
+			PerPage:  cfg.PerPage,
+			MaxPages: cfg.MaxPages,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure forge %q: %w", cfg.Type, err)
+		}
 
-func (gh *GitHub) LoadReposFromUser() {
-	url := fmt.Sprintf("%s/users/%s/repos", githubAPIBaseURL, gh.user.username)
+		if reporter, ok := fg.(forge.ProgressReporter); ok {
+			reporter.OnPage(func(p forge.Page) {
+				// Drop the event rather than block the fetch when nobody is
+				// draining Progress() (e.g. --non-interactive).
+				select {
+				case f.progress <- p:
+				default:
+				}
+			})
+		}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Fatal("failed to create request")
-		return
+		forges = append(forges, fg)
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", gh.user.apiKey))
-	req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
-	req.Header.Add("Accept", "application/vnd.github+json")
-
-	client := http.Client{Timeout: 15 * time.Second}
+	f.forges = forges
+	return f, nil
+}
 
-	res, err := client.Do(req)
-	if err != nil {
-		log.Fatal("failed to do request")
-		return
-	}
+// Progress returns the channel fetch-progress events are published on, for
+// a caller (e.g. the TUI) to surface "Loaded N/? commits from repo X".
+func (f *Forges) Progress() <-chan forge.Page {
+	return f.progress
+}
 
-	defer res.Body.Close()
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		log.Fatal("failed to read data")
-		return
-	}
+// CacheStats sums httpcache hit/revalidation/miss counters across every
+// configured forge that has one (currently only GitHub), so a caller can
+// report how much API quota caching saved.
+func (f *Forges) CacheStats() forge.CacheStats {
+	var total forge.CacheStats
+	for _, fg := range f.forges {
+		reporter, ok := fg.(forge.CacheReporter)
+		if !ok {
+			continue
+		}
 
-	var Repos []Repo
-	if err := json.Unmarshal(body, &Repos); err != nil {
-		log.Fatal("failed to unmarshal data")
-		return
+		stats := reporter.CacheStats()
+		total.Hits += stats.Hits
+		total.Revalidations += stats.Revalidations
+		total.Misses += stats.Misses
 	}
 
-	gh.repos = append(gh.repos, Repos...)
+	return total
 }
 
-func (gh *GitHub) GetRepos() []Repo {
-	return gh.repos
+// choicePrefix formats the forge-qualified prefix shown in front of every
+// repo choice, e.g. "[github@https://api.github.com] RafaZeero/daily-go (Go) -
+// Updated: 2026-07-20". It's keyed by the forge's ID, not its Kind, so two
+// same-kind forges (a self-hosted GitLab alongside gitlab.com) produce
+// distinguishable choices instead of colliding.
+func choicePrefix(id string) string {
+	return fmt.Sprintf("[%s] ", id)
 }
 
-func (gh *GitHub) GetRecentlyUpdatedRepos(daysBack int) []Repo {
-	if daysBack <= 0 {
-		return gh.repos
-	}
+// GetReposChoices returns the repo choices across every configured forge,
+// each prefixed with its forge ID so a selection round-trips back to the
+// forge + repo it came from.
+func (f *Forges) GetReposChoices(ctx context.Context) ([]string, error) {
+	choices := []string{}
 
-	cutoffDate := time.Now().AddDate(0, 0, -daysBack)
-	var recentRepos []Repo
+	for _, fg := range f.forges {
+		repos, err := fg.ListRepos(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("forge %q: %w", fg.Name(), err)
+		}
 
-	for _, repo := range gh.repos {
-		// Check if repository was updated within the specified time period
-		if repo.UpdatedAt.After(cutoffDate) {
-			recentRepos = append(recentRepos, repo)
+		for _, r := range repos {
+			choices = append(choices, choicePrefix(fg.ID())+fmt.Sprint(r))
 		}
 	}
 
-	return recentRepos
+	return choices, nil
 }
 
-func (gh *GitHub) GetReposChoices() []string {
+// GetRecentlyUpdatedRepos returns repo choices, in the same forge-prefixed
+// format as GetReposChoices, for every repo across every configured forge
+// that was updated within the last daysBack days. It's used by
+// --non-interactive to pick repos without a human selecting them.
+func (f *Forges) GetRecentlyUpdatedRepos(ctx context.Context, daysBack int) ([]string, error) {
+	since := time.Now().AddDate(0, 0, -daysBack)
 	choices := []string{}
-	for _, r := range gh.GetRepos() {
-		choices = append(choices, fmt.Sprint(r))
+
+	for _, fg := range f.forges {
+		repos, err := fg.ListRepos(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("forge %q: %w", fg.Name(), err)
+		}
+
+		for _, r := range repos {
+			if r.UpdatedAt.Before(since) {
+				continue
+			}
+			choices = append(choices, choicePrefix(fg.ID())+fmt.Sprint(r))
+		}
 	}
-	return choices
+
+	return choices, nil
 }
 
-func (gh *GitHub) GetRecentlyUpdatedReposChoices(daysBack int) []string {
-	choices := []string{}
-	for _, r := range gh.GetRecentlyUpdatedRepos(daysBack) {
-		choices = append(choices, fmt.Sprint(r))
+// parseChoice splits a forge-prefixed choice string, e.g.
+// "[github@https://api.github.com] owner/repo (Go) - Updated: ...", back into
+// the forge ID and the repo's FullName (e.g. "owner/repo").
+func parseChoice(choice string) (string, string) {
+	id := ""
+	rest := choice
+
+	if strings.HasPrefix(choice, "[") {
+		if end := strings.Index(choice, "] "); end != -1 {
+			id = choice[1:end]
+			rest = choice[end+2:]
+		}
 	}
-	return choices
+
+	name := strings.Split(rest, " (")[0]
+	return id, name
 }
 
-func (gh *GitHub) GetLatestCommits(repoNames []string, daysBack int) ([]Commit, error) {
-	var allCommits []Commit
+// GetActivitySince fetches commits, issues, pull requests, and reviews since
+// daysBack ago for every selected repo choice, routing each one to the forge
+// it was prefixed with. Forges that don't implement forge.ActivityFetcher
+// (e.g. Gerrit) simply contribute commits. Repo choices are fetched
+// concurrently, bounded by f.concurrency, since each one is an independent
+// round trip (or several) to the forge's API.
+//
+// This concurrency is per repo choice, not per branch: ListCommitsSince takes
+// no branch argument, so each repo choice is one fetch, and which branches it
+// covers is up to the forge (the GitHub, GitLab and Gitea endpoints return
+// the default branch; Gerrit's change query spans all branches). Commits are
+// not deduplicated by SHA across choices.
+func (f *Forges) GetActivitySince(ctx context.Context, repoChoices []string, daysBack int) (Activity, error) {
 	since := time.Now().AddDate(0, 0, -daysBack)
 
-	for _, repoName := range repoNames {
-		// Extract repo name from the choice string
-		name := strings.Split(repoName, " (")[0]
+	// Each worker only ever writes to its own index, so the slices can be
+	// merged afterwards without a mutex.
+	perChoice := make([]Activity, len(repoChoices))
+	perChoiceErr := make([]error, len(repoChoices))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < f.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				perChoice[index], perChoiceErr[index] = f.fetchRepoActivity(ctx, repoChoices[index], since)
+			}
+		}()
+	}
 
-		url := fmt.Sprintf("%s/repos/%s/%s/commits?since=%s",
-			githubAPIBaseURL, gh.user.username, name, since.Format(time.RFC3339))
+	for i := range repoChoices {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			close(jobs)
+			wg.Wait()
+			return Activity{}, ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
 
-		req, err := http.NewRequest("GET", url, nil)
+	for _, err := range perChoiceErr {
 		if err != nil {
-			continue
+			return Activity{}, err
 		}
+	}
 
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", gh.user.apiKey))
-		req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
-		req.Header.Add("Accept", "application/vnd.github+json")
+	var activity Activity
+	for _, a := range perChoice {
+		activity.Commits = append(activity.Commits, a.Commits...)
+		activity.Issues = append(activity.Issues, a.Issues...)
+		activity.PullRequests = append(activity.PullRequests, a.PullRequests...)
+		activity.Reviews = append(activity.Reviews, a.Reviews...)
+	}
 
-		client := http.Client{Timeout: 15 * time.Second}
+	// Workers finish in whatever order their fetches happen to complete in,
+	// so re-sort commits by date descending for a stable, newest-first
+	// ordering regardless of which repo choice was fastest.
+	sort.Slice(activity.Commits, func(i, j int) bool {
+		return activity.Commits[i].Date.After(activity.Commits[j].Date)
+	})
 
-		res, err := client.Do(req)
-		if err != nil {
-			continue
+	return activity, nil
+}
+
+// fetchRepoActivity fetches every activity stream for a single repo choice.
+// Errors from individual streams are swallowed (same as the old sequential
+// loop) so one forge/repo having trouble doesn't drop activity for every
+// other choice being fetched concurrently. The only error it returns is a
+// choice that doesn't resolve to any configured forge — that's a
+// configuration mismatch, not a flaky fetch, and silently guessing a forge
+// would risk returning one account's data under another's name.
+func (f *Forges) fetchRepoActivity(ctx context.Context, choice string, since time.Time) (Activity, error) {
+	var activity Activity
+
+	id, repoName := parseChoice(choice)
+
+	fg, err := f.find(id)
+	if err != nil {
+		return activity, err
+	}
+
+	commits, err := fg.ListCommitsSince(ctx, repoName, since)
+	if err != nil {
+		return activity, nil
+	}
+	for _, c := range commits {
+		activity.Commits = append(activity.Commits, Commit{
+			SHA:       c.SHA,
+			Message:   c.Message,
+			Author:    c.Author,
+			Date:      c.Date,
+			RepoName:  c.RepoName,
+			HTMLURL:   c.HTMLURL,
+			ForgeName: fg.Name(),
+			Additions: c.Additions,
+			Deletions: c.Deletions,
+			Files:     c.Files,
+		})
+	}
+
+	fetcher, ok := fg.(forge.ActivityFetcher)
+	if !ok {
+		return activity, nil
+	}
+
+	if issues, err := fetcher.ListIssuesSince(ctx, repoName, since); err == nil {
+		for _, i := range issues {
+			activity.Issues = append(activity.Issues, Issue{
+				Number:    i.Number,
+				Title:     i.Title,
+				State:     i.State,
+				Author:    i.Author,
+				RepoName:  i.RepoName,
+				CreatedAt: i.CreatedAt,
+				ClosedAt:  i.ClosedAt,
+				HTMLURL:   i.HTMLURL,
+				ForgeName: fg.Name(),
+			})
 		}
+	}
 
-		defer res.Body.Close()
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			continue
+	if prs, err := fetcher.ListPullRequestsSince(ctx, repoName, since); err == nil {
+		for _, pr := range prs {
+			activity.PullRequests = append(activity.PullRequests, PullRequest{
+				Number:    pr.Number,
+				Title:     pr.Title,
+				State:     pr.State,
+				Merged:    pr.Merged,
+				Author:    pr.Author,
+				RepoName:  pr.RepoName,
+				CreatedAt: pr.CreatedAt,
+				UpdatedAt: pr.UpdatedAt,
+				HTMLURL:   pr.HTMLURL,
+				ForgeName: fg.Name(),
+			})
 		}
+	}
 
-		var commits []CommitResponse
-		if err := json.Unmarshal(body, &commits); err != nil {
-			continue
+	if reviews, err := fetcher.ListReviewsSince(ctx, repoName, since); err == nil {
+		for _, r := range reviews {
+			activity.Reviews = append(activity.Reviews, Review{
+				PRNumber:    r.PRNumber,
+				RepoName:    r.RepoName,
+				State:       r.State,
+				SubmittedAt: r.SubmittedAt,
+				HTMLURL:     r.HTMLURL,
+				ForgeName:   fg.Name(),
+			})
 		}
+	}
 
-		for _, c := range commits {
-			date, _ := time.Parse(time.RFC3339, c.Commit.Author.Date)
-			author := c.Commit.Author.Name
-			if c.Author != nil {
-				author = c.Author.Login
-			}
+	return activity, nil
+}
 
-			commit := Commit{
-				SHA:      c.SHA[:8],
-				Message:  c.Commit.Message.Message,
-				Author:   author,
-				Date:     date,
-				RepoName: name,
-				HTMLURL:  c.HTMLURL,
-			}
-			allCommits = append(allCommits, commit)
+// find looks up the configured forge with the given ID. It returns an error
+// rather than guessing at f.forges[0] when nothing matches, since a choice
+// that doesn't resolve to a configured forge is a configuration mismatch —
+// silently falling back to another forge would risk fetching one account's
+// data under another's name.
+func (f *Forges) find(id string) (forge.Forge, error) {
+	for _, fg := range f.forges {
+		if fg.ID() == id {
+			return fg, nil
 		}
 	}
-
-	return allCommits, nil
+	return nil, fmt.Errorf("no configured forge matches %q", id)
 }
@@ -0,0 +1,192 @@
+// Package forge abstracts over the code-hosting services daily-go can pull
+// activity from (GitHub, GitLab, Gitea, Gerrit) behind a single interface so
+// the rest of the app doesn't need to know which one it's talking to.
+package forge
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kind identifies which concrete forge implementation to use.
+type Kind string
+
+const (
+	KindGitHub Kind = "github"
+	KindGitLab Kind = "gitlab"
+	KindGitea  Kind = "gitea"
+	KindGerrit Kind = "gerrit"
+)
+
+// Repo is a repository as reported by any forge.
+type Repo struct {
+	Name      string
+	FullName  string
+	Private   bool
+	HTMLURL   string
+	Language  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// String renders r as a repo choice for the TUI picker. It uses FullName,
+// not Name, because FullName is the identifier that actually round-trips
+// back through parseChoice to ListCommitsSince — GitLab projects, for
+// instance, need the full namespace/project path, not the bare name.
+func (r Repo) String() string {
+	return fmt.Sprintf("%s (%s) - Updated: %s", r.FullName, r.Language, r.UpdatedAt.Format("2006-01-02"))
+}
+
+// Commit is a commit as reported by any forge. Additions/Deletions/Files
+// describe what the commit actually changed, when the source supports
+// fetching per-commit detail.
+type Commit struct {
+	SHA       string
+	Message   string
+	Author    string
+	Date      time.Time
+	RepoName  string
+	HTMLURL   string
+	Additions int
+	Deletions int
+	Files     []string
+}
+
+// Forge is the set of operations daily-go needs from a code-hosting service.
+type Forge interface {
+	// Name identifies which kind of forge this is, e.g. for grouping
+	// summaries by forge.
+	Name() Kind
+	// ID uniquely identifies this configured forge connection, unlike
+	// Name: two entries of the same Kind (a self-hosted GitLab alongside
+	// gitlab.com, or two GitHub accounts) have the same Name but distinct
+	// IDs, so repo choices round-trip to the right one. It's the
+	// configured Alias, or "<kind>@<base_url>" if Alias was left empty.
+	ID() string
+	ListRepos(ctx context.Context) ([]Repo, error)
+	// ListCommitsSince fetches commits for repo, which must be a Repo's
+	// FullName (e.g. "owner/repo", or a GitLab namespace/project path) —
+	// the only identifier guaranteed to resolve back to the right project
+	// on every forge.
+	ListCommitsSince(ctx context.Context, repo string, since time.Time) ([]Commit, error)
+}
+
+// Page reports progress through a paginated fetch, e.g. so a TUI spinner
+// can show "Loaded 237/? commits from repo X".
+type Page struct {
+	Repo   string
+	Page   int
+	Loaded int
+	Done   bool
+}
+
+// ProgressReporter is implemented by forges that paginate and want to
+// surface per-page progress. Callers should type-assert for it rather than
+// assume every Forge supports it.
+type ProgressReporter interface {
+	OnPage(func(Page))
+}
+
+// CacheStats mirrors httpcache.Stats without importing internal/httpcache
+// from this package's public API.
+type CacheStats struct {
+	Hits          int64
+	Revalidations int64
+	Misses        int64
+}
+
+// CacheReporter is implemented by forges backed by an on-disk httpcache.
+// Callers should type-assert for it rather than assume every Forge has one.
+type CacheReporter interface {
+	CacheStats() CacheStats
+}
+
+// Issue is an issue as reported by any forge.
+type Issue struct {
+	Number    int
+	Title     string
+	State     string
+	Author    string
+	RepoName  string
+	CreatedAt time.Time
+	ClosedAt  time.Time
+	HTMLURL   string
+}
+
+// PullRequest is a pull/merge request as reported by any forge.
+type PullRequest struct {
+	Number    int
+	Title     string
+	State     string
+	Merged    bool
+	Author    string
+	RepoName  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	HTMLURL   string
+}
+
+// Review is a review the configured user left on a pull/merge request.
+type Review struct {
+	PRNumber    int
+	RepoName    string
+	State       string
+	SubmittedAt time.Time
+	HTMLURL     string
+}
+
+// ActivityFetcher is implemented by forges that can report issues, pull
+// requests, and reviews alongside commits. Callers should type-assert for
+// it rather than assume every Forge supports it.
+type ActivityFetcher interface {
+	ListIssuesSince(ctx context.Context, repo string, since time.Time) ([]Issue, error)
+	ListPullRequestsSince(ctx context.Context, repo string, since time.Time) ([]PullRequest, error)
+	ListReviewsSince(ctx context.Context, repo string, since time.Time) ([]Review, error)
+}
+
+// Options configures a single forge connection.
+type Options struct {
+	Kind     Kind
+	BaseURL  string
+	Token    string
+	Username string
+
+	// Alias becomes the built Forge's ID() if set, so a caller configuring
+	// more than one connection of the same Kind can tell them apart. Left
+	// empty, ID() falls back to "<kind>@<base_url>".
+	Alias string
+
+	// PerPage caps how many items GitHub-style APIs return per page.
+	// Zero uses the implementation's default.
+	PerPage int
+	// MaxPages bounds how many pages are fetched before giving up, to
+	// protect against runaway pagination on very busy repos. Zero means
+	// unbounded (follow rel="next" until exhausted).
+	MaxPages int
+}
+
+// buildID resolves a Forge's ID() from its configured alias, falling back to
+// "<kind>@<baseURL>" when no alias was given.
+func buildID(kind Kind, alias, baseURL string) string {
+	if alias != "" {
+		return alias
+	}
+	return fmt.Sprintf("%s@%s", kind, baseURL)
+}
+
+// New builds the concrete Forge implementation described by opts.
+func New(opts Options) (Forge, error) {
+	switch opts.Kind {
+	case KindGitHub, "":
+		return NewGitHub(opts), nil
+	case KindGitLab:
+		return NewGitLab(opts), nil
+	case KindGitea:
+		return NewGitea(opts), nil
+	case KindGerrit:
+		return NewGerrit(opts), nil
+	default:
+		return nil, fmt.Errorf("forge: unknown kind %q", opts.Kind)
+	}
+}
@@ -0,0 +1,165 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// gerritMagicPrefix is prepended by Gerrit to every JSON response to guard
+// against XSSI; it must be stripped before unmarshalling.
+const gerritMagicPrefix = ")]}'"
+
+type gerritProject struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type gerritAccount struct {
+	Name string `json:"name"`
+}
+
+type gerritChange struct {
+	Project  string        `json:"project"`
+	Subject  string        `json:"subject"`
+	Updated  string        `json:"updated"`
+	Owner    gerritAccount `json:"owner"`
+	ChangeID string        `json:"change_id"`
+	Number   int           `json:"_number"`
+}
+
+// ForgeGerrit talks to a Gerrit Code Review REST API.
+type ForgeGerrit struct {
+	id       string
+	baseURL  string
+	token    string
+	username string
+	client   *http.Client
+}
+
+// NewGerrit builds a ForgeGerrit from opts. BaseURL must point at the
+// Gerrit instance, e.g. "https://gerrit.example.com".
+func NewGerrit(opts Options) *ForgeGerrit {
+	return &ForgeGerrit{
+		id:       buildID(KindGerrit, opts.Alias, opts.BaseURL),
+		baseURL:  opts.BaseURL,
+		token:    opts.Token,
+		username: opts.Username,
+		client:   &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+func (g *ForgeGerrit) Name() Kind { return KindGerrit }
+
+func (g *ForgeGerrit) ID() string { return g.id }
+
+func (g *ForgeGerrit) newRequest(ctx context.Context, method, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(g.username, g.token)
+
+	return req, nil
+}
+
+// do issues req, strips Gerrit's ")]}'" XSSI-prevention prefix from the
+// response body, and returns an error if the status wasn't 2xx so a
+// 404/401 surfaces clearly instead of as a confusing unmarshal failure.
+func (g *ForgeGerrit) do(req *http.Request) ([]byte, error) {
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	body = []byte(strings.TrimPrefix(string(body), gerritMagicPrefix))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("request failed with status %d: %s", res.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+func (g *ForgeGerrit) ListRepos(ctx context.Context) ([]Repo, error) {
+	url := fmt.Sprintf("%s/a/projects/?d", g.baseURL)
+
+	req, err := g.newRequest(ctx, "GET", url)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to create request: %w", err)
+	}
+
+	body, err := g.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to list projects: %w", err)
+	}
+
+	var projects map[string]gerritProject
+	if err := json.Unmarshal(body, &projects); err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to unmarshal projects: %w", err)
+	}
+
+	result := make([]Repo, 0, len(projects))
+	for name := range projects {
+		result = append(result, Repo{
+			Name:     name,
+			FullName: name,
+			HTMLURL:  fmt.Sprintf("%s/admin/repos/%s", g.baseURL, name),
+		})
+	}
+
+	return result, nil
+}
+
+func (g *ForgeGerrit) ListCommitsSince(ctx context.Context, repo string, since time.Time) ([]Commit, error) {
+	query := fmt.Sprintf("project:%s owner:%s since:%s", repo, g.username, since.Format("2006-01-02"))
+	url := fmt.Sprintf("%s/a/changes/?q=%s", g.baseURL, strings.ReplaceAll(query, " ", "+"))
+
+	req, err := g.newRequest(ctx, "GET", url)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to create request: %w", err)
+	}
+
+	body, err := g.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to list changes: %w", err)
+	}
+
+	var changes []gerritChange
+	if err := json.Unmarshal(body, &changes); err != nil {
+		return nil, fmt.Errorf("forge/gerrit: failed to unmarshal changes: %w", err)
+	}
+
+	result := make([]Commit, 0, len(changes))
+	for _, c := range changes {
+		date, _ := time.Parse("2006-01-02 15:04:05.000000000", c.Updated)
+
+		sha := c.ChangeID
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+
+		result = append(result, Commit{
+			SHA:      sha,
+			Message:  c.Subject,
+			Author:   c.Owner.Name,
+			Date:     date,
+			RepoName: repo,
+			HTMLURL:  fmt.Sprintf("%s/c/%s/+/%d", g.baseURL, repo, c.Number),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,189 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type giteaRepo struct {
+	Name      string    `json:"name"`
+	FullName  string    `json:"full_name"`
+	Private   bool      `json:"private"`
+	HTMLURL   string    `json:"html_url"`
+	Language  string    `json:"language"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type giteaCommitAuthor struct {
+	Name string `json:"name"`
+	Date string `json:"date"`
+}
+
+type giteaRepoCommit struct {
+	Message string            `json:"message"`
+	Author  giteaCommitAuthor `json:"author"`
+}
+
+type giteaCommit struct {
+	SHA     string          `json:"sha"`
+	Commit  giteaRepoCommit `json:"commit"`
+	HTMLURL string          `json:"html_url"`
+	Author  *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// ForgeGitea talks to the Gitea REST API, which is a close cousin of
+// GitHub's but served under /api/v1 and without the versioned-header dance.
+type ForgeGitea struct {
+	id       string
+	baseURL  string
+	token    string
+	username string
+	client   *http.Client
+}
+
+// NewGitea builds a ForgeGitea from opts. BaseURL must point at the
+// self-hosted instance, e.g. "https://gitea.example.com".
+func NewGitea(opts Options) *ForgeGitea {
+	return &ForgeGitea{
+		id:       buildID(KindGitea, opts.Alias, opts.BaseURL),
+		baseURL:  opts.BaseURL,
+		token:    opts.Token,
+		username: opts.Username,
+		client:   &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// checkGiteaStatus returns an error describing a non-2xx Gitea response, so
+// a 404/401 surfaces as a clear status-code error instead of a confusing
+// "failed to unmarshal" once the caller tries to decode the error body as
+// the expected JSON shape.
+func checkGiteaStatus(status int, body []byte) error {
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("forge/gitea: request failed with status %d: %s", status, string(body))
+	}
+
+	return nil
+}
+
+func (g *ForgeGitea) Name() Kind { return KindGitea }
+
+func (g *ForgeGitea) ID() string { return g.id }
+
+func (g *ForgeGitea) newRequest(ctx context.Context, method, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", g.token))
+	req.Header.Add("Accept", "application/json")
+
+	return req, nil
+}
+
+func (g *ForgeGitea) ListRepos(ctx context.Context) ([]Repo, error) {
+	url := fmt.Sprintf("%s/api/v1/users/%s/repos", g.baseURL, g.username)
+
+	req, err := g.newRequest(ctx, "GET", url)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to create request: %w", err)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to list repos: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to read response: %w", err)
+	}
+
+	if err := checkGiteaStatus(res.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var repos []giteaRepo
+	if err := json.Unmarshal(body, &repos); err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to unmarshal repos: %w", err)
+	}
+
+	result := make([]Repo, 0, len(repos))
+	for _, r := range repos {
+		result = append(result, Repo{
+			Name:      r.Name,
+			FullName:  r.FullName,
+			Private:   r.Private,
+			HTMLURL:   r.HTMLURL,
+			Language:  r.Language,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (g *ForgeGitea) ListCommitsSince(ctx context.Context, repo string, since time.Time) ([]Commit, error) {
+	url := fmt.Sprintf("%s/api/v1/repos/%s/commits?since=%s",
+		g.baseURL, repo, since.Format(time.RFC3339))
+
+	req, err := g.newRequest(ctx, "GET", url)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to create request: %w", err)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to list commits: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to read response: %w", err)
+	}
+
+	if err := checkGiteaStatus(res.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var commits []giteaCommit
+	if err := json.Unmarshal(body, &commits); err != nil {
+		return nil, fmt.Errorf("forge/gitea: failed to unmarshal commits: %w", err)
+	}
+
+	result := make([]Commit, 0, len(commits))
+	for _, c := range commits {
+		author := c.Commit.Author.Name
+		if c.Author != nil {
+			author = c.Author.Login
+		}
+
+		date, _ := time.Parse(time.RFC3339, c.Commit.Author.Date)
+
+		sha := c.SHA
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+
+		result = append(result, Commit{
+			SHA:      sha,
+			Message:  c.Commit.Message,
+			Author:   author,
+			Date:     date,
+			RepoName: repo,
+			HTMLURL:  c.HTMLURL,
+		})
+	}
+
+	return result, nil
+}
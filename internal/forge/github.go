@@ -0,0 +1,414 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/RafaZeero/daily-go/internal/httpcache"
+)
+
+const (
+	defaultGitHubBaseURL = "https://api.github.com"
+	defaultGitHubPerPage = 30
+	githubMaxPageSafety  = 100 // GitHub's own per_page ceiling
+)
+
+type githubRepo struct {
+	Name      string    `json:"name"`
+	FullName  string    `json:"full_name"`
+	Private   bool      `json:"private"`
+	HTMLURL   string    `json:"html_url"`
+	Language  string    `json:"language"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type githubCommitMessage struct {
+	Message string `json:"message"`
+}
+
+type githubCommitAuthor struct {
+	Name string `json:"name"`
+	Date string `json:"date"`
+}
+
+type githubCommitDetails struct {
+	Message githubCommitMessage `json:"message"`
+	Author  githubCommitAuthor  `json:"author"`
+}
+
+type githubCommitResponse struct {
+	SHA     string              `json:"sha"`
+	Commit  githubCommitDetails `json:"commit"`
+	HTMLURL string              `json:"html_url"`
+	Author  *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+type githubCommitDetail struct {
+	Files []struct {
+		Filename string `json:"filename"`
+	} `json:"files"`
+	Stats struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+	} `json:"stats"`
+}
+
+// ForgeGitHub talks to the GitHub REST API. Responses are routed through an
+// on-disk httpcache.Cache so repeated runs revalidate with ETags instead of
+// spending fresh rate-limit quota on unchanged data. Requests are retried
+// with exponential backoff on 5xx responses, retried after the server's
+// Retry-After delay on secondary rate limits (that delay is used as given and
+// does not grow between attempts), and give up after maxConsecutive403s in a
+// row rather than hammering the API with a bad token.
+type ForgeGitHub struct {
+	id       string
+	baseURL  string
+	token    string
+	username string
+	client   *http.Client
+	cache    *httpcache.Cache
+	perPage  int
+	maxPages int
+	onPage   func(Page)
+
+	consecutive403s int
+}
+
+// NewGitHub builds a ForgeGitHub from opts. If the on-disk cache can't be
+// initialized (e.g. no writable home directory), requests simply go
+// uncached rather than failing construction.
+func NewGitHub(opts Options) *ForgeGitHub {
+	baseURL := opts.BaseURL
+	if baseURL == "" {
+		baseURL = defaultGitHubBaseURL
+	}
+
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		perPage = defaultGitHubPerPage
+	}
+	if perPage > githubMaxPageSafety {
+		perPage = githubMaxPageSafety
+	}
+
+	cache, _ := httpcache.New()
+
+	return &ForgeGitHub{
+		id:       buildID(KindGitHub, opts.Alias, baseURL),
+		baseURL:  baseURL,
+		token:    opts.Token,
+		username: opts.Username,
+		client:   &http.Client{Timeout: 15 * time.Second},
+		cache:    cache,
+		perPage:  perPage,
+		maxPages: opts.MaxPages,
+	}
+}
+
+// OnPage registers a callback invoked after each page is fetched, so a
+// caller such as the TUI can surface fetch progress. Satisfies
+// ProgressReporter.
+func (g *ForgeGitHub) OnPage(fn func(Page)) {
+	g.onPage = fn
+}
+
+func (g *ForgeGitHub) reportPage(repo string, page, loaded int, done bool) {
+	if g.onPage != nil {
+		g.onPage(Page{Repo: repo, Page: page, Loaded: loaded, Done: done})
+	}
+}
+
+// CacheStats reports how effective the on-disk httpcache has been for this
+// forge. Satisfies CacheReporter. Returns a zero value if the cache couldn't
+// be initialized.
+func (g *ForgeGitHub) CacheStats() CacheStats {
+	if g.cache == nil {
+		return CacheStats{}
+	}
+
+	stats := g.cache.Stats()
+	return CacheStats{Hits: stats.Hits, Revalidations: stats.Revalidations, Misses: stats.Misses}
+}
+
+// nextLink extracts the rel="next" URL from a GitHub Link response header,
+// returning "" once there is no further page.
+func nextLink(header http.Header) string {
+	link := header.Get("Link")
+	if link == "" {
+		return ""
+	}
+
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		url := strings.Trim(strings.TrimSpace(segments[0]), "<>")
+		for _, seg := range segments[1:] {
+			if strings.TrimSpace(seg) == `rel="next"` {
+				return url
+			}
+		}
+	}
+
+	return ""
+}
+
+// do issues req, routing it through the httpcache when available, and
+// returns the (possibly cached) response body, headers, and status code.
+func (g *ForgeGitHub) do(req *http.Request) ([]byte, http.Header, int, error) {
+	if g.cache == nil {
+		res, err := g.client.Do(req)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		return body, res.Header, res.StatusCode, nil
+	}
+
+	res, body, err := g.cache.Do(g.client, req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return body, res.Header, res.StatusCode, nil
+}
+
+// doWithRetry issues req, retrying with exponential backoff on 5xx responses
+// and after the Retry-After delay (not growing between attempts) on
+// secondary rate limits. It returns a rate-limit error immediately (without
+// retrying) when the primary rate limit is exhausted: the cache's
+// *httpcache.ErrRateLimited when requests go through the cache, a
+// *RateLimitError otherwise. It gives up after maxConsecutive403s in a row.
+func (g *ForgeGitHub) doWithRetry(ctx context.Context, req *http.Request) ([]byte, http.Header, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		body, header, status, err := g.do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if status == http.StatusForbidden || status == http.StatusTooManyRequests {
+			if rl := rateLimitFromHeader(status, header); rl != nil {
+				return nil, nil, rl
+			}
+		}
+
+		if status == http.StatusForbidden {
+			if rl := secondaryRateLimit(status, header); rl != nil {
+				g.consecutive403s = 0
+				if err := sleep(ctx, rl.RetryAfter); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+
+			g.consecutive403s++
+			if g.consecutive403s >= maxConsecutive403s {
+				return nil, nil, fmt.Errorf("forge/github: got %d consecutive 403s, giving up (check your token): %s", g.consecutive403s, string(body))
+			}
+			lastErr = fmt.Errorf("forge/github: request failed with status 403: %s", string(body))
+			continue
+		}
+		g.consecutive403s = 0
+
+		if status >= 500 {
+			lastErr = fmt.Errorf("forge/github: request failed with status %d: %s", status, string(body))
+			if err := sleep(ctx, backoff(attempt)); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		if status != http.StatusOK && status != http.StatusNotModified {
+			return nil, nil, fmt.Errorf("forge/github: request failed with status %d: %s", status, string(body))
+		}
+
+		return body, header, nil
+	}
+
+	return nil, nil, fmt.Errorf("forge/github: exceeded %d retries: %w", maxRetries, lastErr)
+}
+
+func (g *ForgeGitHub) Name() Kind { return KindGitHub }
+
+func (g *ForgeGitHub) ID() string { return g.id }
+
+func (g *ForgeGitHub) newRequest(ctx context.Context, method, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", g.token))
+	req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Add("Accept", "application/vnd.github+json")
+
+	return req, nil
+}
+
+func (g *ForgeGitHub) ListRepos(ctx context.Context) ([]Repo, error) {
+	url := fmt.Sprintf("%s/users/%s/repos?per_page=%d", g.baseURL, g.username, g.perPage)
+
+	var result []Repo
+	page := 1
+
+	for url != "" {
+		req, err := g.newRequest(ctx, "GET", url)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+		}
+
+		body, header, err := g.doWithRetry(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to list repos: %w", err)
+		}
+
+		var repos []githubRepo
+		if err := json.Unmarshal(body, &repos); err != nil {
+			return nil, fmt.Errorf("forge/github: failed to unmarshal repos: %w", err)
+		}
+
+		for _, r := range repos {
+			result = append(result, Repo{
+				Name:      r.Name,
+				FullName:  r.FullName,
+				Private:   r.Private,
+				HTMLURL:   r.HTMLURL,
+				Language:  r.Language,
+				CreatedAt: r.CreatedAt,
+				UpdatedAt: r.UpdatedAt,
+			})
+		}
+
+		g.reportPage(g.username, page, len(result), false)
+
+		if g.maxPages > 0 && page >= g.maxPages {
+			break
+		}
+
+		url = nextLink(header)
+		page++
+	}
+
+	g.reportPage(g.username, page, len(result), true)
+
+	return result, nil
+}
+
+func (g *ForgeGitHub) ListCommitsSince(ctx context.Context, repo string, since time.Time) ([]Commit, error) {
+	url := fmt.Sprintf("%s/repos/%s/commits?since=%s&per_page=%d",
+		g.baseURL, repo, since.Format(time.RFC3339), g.perPage)
+
+	var result []Commit
+	page := 1
+
+	for url != "" {
+		req, err := g.newRequest(ctx, "GET", url)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+		}
+
+		body, header, err := g.doWithRetry(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to list commits: %w", err)
+		}
+
+		var commits []githubCommitResponse
+		if err := json.Unmarshal(body, &commits); err != nil {
+			return nil, fmt.Errorf("forge/github: failed to unmarshal commits: %w", err)
+		}
+
+		for _, c := range commits {
+			date, _ := time.Parse(time.RFC3339, c.Commit.Author.Date)
+			author := c.Commit.Author.Name
+			if c.Author != nil {
+				author = c.Author.Login
+			}
+
+			sha := c.SHA
+			if len(sha) > 8 {
+				sha = sha[:8]
+			}
+
+			commit := Commit{
+				SHA:      sha,
+				Message:  c.Commit.Message.Message,
+				Author:   author,
+				Date:     date,
+				RepoName: repo,
+				HTMLURL:  c.HTMLURL,
+			}
+
+			if additions, deletions, files, err := g.commitDetail(ctx, repo, c.SHA); err == nil {
+				commit.Additions = additions
+				commit.Deletions = deletions
+				commit.Files = files
+			}
+			// Diff stats are an enrichment, not the commit itself — a
+			// single bad lookup shouldn't fail the whole listing.
+
+			result = append(result, commit)
+		}
+
+		g.reportPage(repo, page, len(result), false)
+
+		if g.maxPages > 0 && page >= g.maxPages {
+			break
+		}
+
+		url = nextLink(header)
+		page++
+	}
+
+	g.reportPage(repo, page, len(result), true)
+
+	return result, nil
+}
+
+// commitDetail fetches the file-level diff stats for a single commit. The
+// result is routed through doWithRetry, which goes through the same
+// httpcache.Cache as every other request; since a commit's SHA is
+// immutable, its ETag never changes, so repeated lookups revalidate to a
+// 304 instead of re-downloading the diff.
+func (g *ForgeGitHub) commitDetail(ctx context.Context, repo, sha string) (additions, deletions int, files []string, err error) {
+	url := fmt.Sprintf("%s/repos/%s/commits/%s", g.baseURL, repo, sha)
+
+	req, err := g.newRequest(ctx, "GET", url)
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+	}
+
+	body, _, err := g.doWithRetry(ctx, req)
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("forge/github: failed to fetch commit detail: %w", err)
+	}
+
+	var detail githubCommitDetail
+	if err := json.Unmarshal(body, &detail); err != nil {
+		return 0, 0, nil, fmt.Errorf("forge/github: failed to unmarshal commit detail: %w", err)
+	}
+
+	files = make([]string, 0, len(detail.Files))
+	for _, f := range detail.Files {
+		files = append(files, f.Filename)
+	}
+
+	return detail.Stats.Additions, detail.Stats.Deletions, files, nil
+}
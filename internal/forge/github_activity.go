@@ -0,0 +1,219 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type githubIssue struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	HTMLURL   string     `json:"html_url"`
+	CreatedAt time.Time  `json:"created_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	// PullRequest is present on issues that are actually pull requests;
+	// the issues endpoint returns both, so this is how we tell them apart.
+	PullRequest *struct{} `json:"pull_request"`
+}
+
+type githubPullRequest struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	HTMLURL   string     `json:"html_url"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type githubReview struct {
+	State       string    `json:"state"`
+	HTMLURL     string    `json:"html_url"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	User        struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// ListIssuesSince returns issues updated since `since`, excluding pull
+// requests (GitHub's issues endpoint returns both), following Link:
+// rel="next" the same way ListRepos/ListCommitsSince do so a daysBack
+// window with more than one page of activity isn't silently truncated.
+func (g *ForgeGitHub) ListIssuesSince(ctx context.Context, repo string, since time.Time) ([]Issue, error) {
+	url := fmt.Sprintf("%s/repos/%s/issues?state=all&since=%s&per_page=%d",
+		g.baseURL, repo, since.Format(time.RFC3339), g.perPage)
+
+	var result []Issue
+	page := 1
+
+	for url != "" {
+		req, err := g.newRequest(ctx, "GET", url)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+		}
+
+		body, header, err := g.doWithRetry(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to list issues: %w", err)
+		}
+
+		var issues []githubIssue
+		if err := json.Unmarshal(body, &issues); err != nil {
+			return nil, fmt.Errorf("forge/github: failed to unmarshal issues: %w", err)
+		}
+
+		for _, i := range issues {
+			if i.PullRequest != nil {
+				continue
+			}
+
+			var closedAt time.Time
+			if i.ClosedAt != nil {
+				closedAt = *i.ClosedAt
+			}
+
+			result = append(result, Issue{
+				Number:    i.Number,
+				Title:     i.Title,
+				State:     i.State,
+				Author:    i.User.Login,
+				RepoName:  repo,
+				CreatedAt: i.CreatedAt,
+				ClosedAt:  closedAt,
+				HTMLURL:   i.HTMLURL,
+			})
+		}
+
+		if g.maxPages > 0 && page >= g.maxPages {
+			break
+		}
+
+		url = nextLink(header)
+		page++
+	}
+
+	return result, nil
+}
+
+// ListPullRequestsSince returns pull requests updated since `since`,
+// following Link: rel="next" like ListIssuesSince.
+func (g *ForgeGitHub) ListPullRequestsSince(ctx context.Context, repo string, since time.Time) ([]PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls?state=all&sort=updated&direction=desc&per_page=%d",
+		g.baseURL, repo, g.perPage)
+
+	var result []PullRequest
+	page := 1
+
+	for url != "" {
+		req, err := g.newRequest(ctx, "GET", url)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+		}
+
+		body, header, err := g.doWithRetry(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("forge/github: failed to list pull requests: %w", err)
+		}
+
+		var prs []githubPullRequest
+		if err := json.Unmarshal(body, &prs); err != nil {
+			return nil, fmt.Errorf("forge/github: failed to unmarshal pull requests: %w", err)
+		}
+
+		// The pulls endpoint has no server-side "since" filter, so this
+		// relies on sort=updated&direction=desc: once a page's last PR is
+		// older than since, every PR after it (this page and beyond) is
+		// too, and pagination can stop instead of walking the repo's
+		// entire PR history.
+		reachedCutoff := false
+		for _, pr := range prs {
+			if pr.UpdatedAt.Before(since) {
+				reachedCutoff = true
+				continue
+			}
+
+			result = append(result, PullRequest{
+				Number:    pr.Number,
+				Title:     pr.Title,
+				State:     pr.State,
+				Merged:    pr.MergedAt != nil,
+				Author:    pr.User.Login,
+				RepoName:  repo,
+				CreatedAt: pr.CreatedAt,
+				UpdatedAt: pr.UpdatedAt,
+				HTMLURL:   pr.HTMLURL,
+			})
+		}
+
+		if reachedCutoff {
+			break
+		}
+
+		if g.maxPages > 0 && page >= g.maxPages {
+			break
+		}
+
+		url = nextLink(header)
+		page++
+	}
+
+	return result, nil
+}
+
+// ListReviewsSince returns reviews authored by the configured user, across
+// every pull request updated since `since`.
+func (g *ForgeGitHub) ListReviewsSince(ctx context.Context, repo string, since time.Time) ([]Review, error) {
+	prs, err := g.ListPullRequestsSince(ctx, repo, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Review
+	for _, pr := range prs {
+		url := fmt.Sprintf("%s/repos/%s/pulls/%d/reviews?per_page=%d", g.baseURL, repo, pr.Number, g.perPage)
+
+		for url != "" {
+			req, err := g.newRequest(ctx, "GET", url)
+			if err != nil {
+				return nil, fmt.Errorf("forge/github: failed to create request: %w", err)
+			}
+
+			body, header, err := g.doWithRetry(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("forge/github: failed to list reviews for PR #%d: %w", pr.Number, err)
+			}
+
+			var reviews []githubReview
+			if err := json.Unmarshal(body, &reviews); err != nil {
+				return nil, fmt.Errorf("forge/github: failed to unmarshal reviews for PR #%d: %w", pr.Number, err)
+			}
+
+			for _, r := range reviews {
+				if r.User.Login != g.username || r.SubmittedAt.Before(since) {
+					continue
+				}
+
+				result = append(result, Review{
+					PRNumber:    pr.Number,
+					RepoName:    repo,
+					State:       r.State,
+					SubmittedAt: r.SubmittedAt,
+					HTMLURL:     r.HTMLURL,
+				})
+			}
+
+			url = nextLink(header)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package forge
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNextLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   string
+	}{
+		{
+			name:   "no Link header",
+			header: http.Header{},
+			want:   "",
+		},
+		{
+			name:   "single next link",
+			header: http.Header{"Link": []string{`<https://api.github.com/repos/x/y/commits?page=2>; rel="next"`}},
+			want:   "https://api.github.com/repos/x/y/commits?page=2",
+		},
+		{
+			name: "next and last, next comes first",
+			header: http.Header{"Link": []string{
+				`<https://api.github.com/repos/x/y/commits?page=2>; rel="next", <https://api.github.com/repos/x/y/commits?page=5>; rel="last"`,
+			}},
+			want: "https://api.github.com/repos/x/y/commits?page=2",
+		},
+		{
+			name: "last and next, next comes second",
+			header: http.Header{"Link": []string{
+				`<https://api.github.com/repos/x/y/commits?page=5>; rel="last", <https://api.github.com/repos/x/y/commits?page=2>; rel="next"`,
+			}},
+			want: "https://api.github.com/repos/x/y/commits?page=2",
+		},
+		{
+			name:   "only a last link, no next",
+			header: http.Header{"Link": []string{`<https://api.github.com/repos/x/y/commits?page=5>; rel="last"`}},
+			want:   "",
+		},
+		{
+			name:   "malformed Link header",
+			header: http.Header{"Link": []string{"not a link header at all"}},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextLink(tt.header); got != tt.want {
+				t.Errorf("nextLink(%v) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
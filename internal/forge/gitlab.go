@@ -0,0 +1,179 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const defaultGitLabBaseURL = "https://gitlab.com"
+
+type gitlabProject struct {
+	Name          string    `json:"name"`
+	PathNamespace string    `json:"path_with_namespace"`
+	Visibility    string    `json:"visibility"`
+	WebURL        string    `json:"web_url"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastActivity  time.Time `json:"last_activity_at"`
+}
+
+type gitlabCommit struct {
+	ID            string    `json:"id"`
+	Title         string    `json:"title"`
+	AuthorName    string    `json:"author_name"`
+	CommittedDate time.Time `json:"committed_date"`
+	WebURL        string    `json:"web_url"`
+}
+
+// ForgeGitLab talks to the GitLab REST API.
+type ForgeGitLab struct {
+	id       string
+	baseURL  string
+	token    string
+	username string
+	client   *http.Client
+}
+
+// NewGitLab builds a ForgeGitLab from opts.
+func NewGitLab(opts Options) *ForgeGitLab {
+	baseURL := opts.BaseURL
+	if baseURL == "" {
+		baseURL = defaultGitLabBaseURL
+	}
+
+	return &ForgeGitLab{
+		id:       buildID(KindGitLab, opts.Alias, baseURL),
+		baseURL:  baseURL,
+		token:    opts.Token,
+		username: opts.Username,
+		client:   &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// checkGitLabStatus returns an error describing a non-2xx GitLab response, so a
+// 404/401 surfaces as a clear status-code error instead of a confusing
+// "failed to unmarshal" once the caller tries to decode the error body as
+// the expected JSON shape.
+func checkGitLabStatus(status int, body []byte) error {
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("forge/gitlab: request failed with status %d: %s", status, string(body))
+	}
+
+	return nil
+}
+
+func (g *ForgeGitLab) Name() Kind { return KindGitLab }
+
+func (g *ForgeGitLab) ID() string { return g.id }
+
+func (g *ForgeGitLab) newRequest(ctx context.Context, method, reqURL string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("PRIVATE-TOKEN", g.token)
+
+	return req, nil
+}
+
+func (g *ForgeGitLab) ListRepos(ctx context.Context) ([]Repo, error) {
+	reqURL := fmt.Sprintf("%s/api/v4/users/%s/projects", g.baseURL, url.PathEscape(g.username))
+
+	req, err := g.newRequest(ctx, "GET", reqURL)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to create request: %w", err)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to list projects: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to read response: %w", err)
+	}
+
+	if err := checkGitLabStatus(res.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var projects []gitlabProject
+	if err := json.Unmarshal(body, &projects); err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to unmarshal projects: %w", err)
+	}
+
+	result := make([]Repo, 0, len(projects))
+	for _, p := range projects {
+		result = append(result, Repo{
+			Name:      p.Name,
+			FullName:  p.PathNamespace,
+			Private:   p.Visibility != "public",
+			HTMLURL:   p.WebURL,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.LastActivity,
+		})
+	}
+
+	return result, nil
+}
+
+// ListCommitsSince lists commits for repo, which GitLab's API requires to be
+// either a numeric project ID or the URL-encoded namespace/project path —
+// repo is expected to be a Repo's FullName (path_with_namespace), not its
+// bare Name, so it round-trips correctly for projects outside g.username's
+// own namespace.
+func (g *ForgeGitLab) ListCommitsSince(ctx context.Context, repo string, since time.Time) ([]Commit, error) {
+	reqURL := fmt.Sprintf("%s/api/v4/projects/%s/repository/commits?since=%s",
+		g.baseURL, url.PathEscape(repo), url.QueryEscape(since.Format(time.RFC3339)))
+
+	req, err := g.newRequest(ctx, "GET", reqURL)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to create request: %w", err)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to list commits: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to read response: %w", err)
+	}
+
+	if err := checkGitLabStatus(res.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var commits []gitlabCommit
+	if err := json.Unmarshal(body, &commits); err != nil {
+		return nil, fmt.Errorf("forge/gitlab: failed to unmarshal commits: %w", err)
+	}
+
+	result := make([]Commit, 0, len(commits))
+	for _, c := range commits {
+		sha := c.ID
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+
+		result = append(result, Commit{
+			SHA:      sha,
+			Message:  c.Title,
+			Author:   c.AuthorName,
+			Date:     c.CommittedDate,
+			RepoName: repo,
+			HTMLURL:  c.WebURL,
+		})
+	}
+
+	return result, nil
+}
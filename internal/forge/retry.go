@@ -0,0 +1,95 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxConsecutive403s stops ForgeGitHub from hammering the API with a
+	// bad/revoked token instead of retrying 403s forever.
+	maxConsecutive403s = 3
+	maxRetries         = 5
+	baseBackoff        = 1 * time.Second
+)
+
+// RateLimitError is returned when GitHub has rejected a request for being
+// over its rate limit, carrying how long the caller should wait before
+// trying again.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("forge/github: rate limited, retry after %s", e.RetryAfter)
+}
+
+// rateLimitFromHeader reports the primary rate limit being exhausted, read
+// from GitHub's X-RateLimit-Remaining/X-RateLimit-Reset headers. Unlike a
+// secondary rate limit, this isn't retried automatically — blocking an
+// interactive CLI for up to an hour would be poor UX, so callers get the
+// error immediately and can decide what to do.
+//
+// This must only be called for a response GitHub actually rejected (403/429):
+// X-RateLimit-Remaining: 0 also shows up on the 200/304 that legitimately
+// spends the last unit of quota, and that response already has a usable body
+// that callers shouldn't discard.
+func rateLimitFromHeader(status int, header http.Header) *RateLimitError {
+	if status != http.StatusForbidden && status != http.StatusTooManyRequests {
+		return nil
+	}
+
+	if header.Get("X-RateLimit-Remaining") != "0" {
+		return nil
+	}
+
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	retryAfter := time.Until(time.Unix(reset, 0))
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+
+	return &RateLimitError{RetryAfter: retryAfter}
+}
+
+// secondaryRateLimit reports GitHub's secondary rate limit, signaled by a
+// 403 with a Retry-After header. Unlike the primary limit, this is short and
+// bounded, so it's safe to sleep and retry automatically.
+func secondaryRateLimit(status int, header http.Header) *RateLimitError {
+	if status != http.StatusForbidden {
+		return nil
+	}
+
+	secs, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil {
+		return nil
+	}
+
+	return &RateLimitError{RetryAfter: time.Duration(secs) * time.Second}
+}
+
+// backoff returns the exponential backoff duration for the given attempt
+// (0-indexed), doubling from baseBackoff.
+func backoff(attempt int) time.Duration {
+	return baseBackoff * (1 << attempt)
+}
+
+// sleep blocks for d, or returns ctx's error if it's canceled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package forge
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitFromHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		want   bool
+	}{
+		{
+			name:   "200 with exhausted quota is not an error",
+			status: http.StatusOK,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			want:   false,
+		},
+		{
+			name:   "304 with exhausted quota is not an error",
+			status: http.StatusNotModified,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			want:   false,
+		},
+		{
+			name:   "403 with remaining quota is not a rate limit",
+			status: http.StatusForbidden,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"10"}},
+			want:   false,
+		},
+		{
+			name:   "403 with exhausted quota and no reset header",
+			status: http.StatusForbidden,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			want:   false,
+		},
+		{
+			name:   "403 with exhausted quota is a rate limit",
+			status: http.StatusForbidden,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"9999999999"},
+			},
+			want: true,
+		},
+		{
+			name:   "429 with exhausted quota is a rate limit",
+			status: http.StatusTooManyRequests,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"9999999999"},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rateLimitFromHeader(tt.status, tt.header)
+			if (got != nil) != tt.want {
+				t.Errorf("rateLimitFromHeader(%d, %v) = %v, want non-nil = %v", tt.status, tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecondaryRateLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		want   time.Duration
+	}{
+		{
+			name:   "403 with Retry-After",
+			status: http.StatusForbidden,
+			header: http.Header{"Retry-After": []string{"30"}},
+			want:   30 * time.Second,
+		},
+		{
+			name:   "403 without Retry-After is not a secondary limit",
+			status: http.StatusForbidden,
+			header: http.Header{},
+			want:   0,
+		},
+		{
+			name:   "non-403 is never a secondary limit",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": []string{"30"}},
+			want:   0,
+		},
+		{
+			name:   "malformed Retry-After is ignored",
+			status: http.StatusForbidden,
+			header: http.Header{"Retry-After": []string{"soon"}},
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := secondaryRateLimit(tt.status, tt.header)
+			if tt.want == 0 {
+				if got != nil {
+					t.Errorf("secondaryRateLimit(%d, %v) = %v, want nil", tt.status, tt.header, got)
+				}
+				return
+			}
+			if got == nil || got.RetryAfter != tt.want {
+				t.Errorf("secondaryRateLimit(%d, %v) = %v, want RetryAfter %v", tt.status, tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 1 * time.Second},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
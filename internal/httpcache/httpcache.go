@@ -0,0 +1,219 @@
+// Package httpcache caches GitHub API responses on disk so repeated runs of
+// the TUI within the same day don't burn through the 5000 req/hr token
+// budget. It keys entries by request URL + auth, stores the ETag/
+// Last-Modified headers alongside the body, and revalidates with
+// If-None-Match/If-Modified-Since on every subsequent request. Endpoints
+// that don't return either header (e.g. /user/repos) are instead trusted
+// for a bounded TTL. Stats reports cumulative hit/revalidation/miss counts.
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// defaultTTL bounds how long an entry with no ETag/Last-Modified (e.g.
+// /user/repos, which GitHub doesn't version) is served from disk before a
+// fresh request is made.
+const defaultTTL = 5 * time.Minute
+
+// ErrRateLimited is returned when the GitHub primary rate limit has been
+// exhausted and the caller should back off until Reset.
+type ErrRateLimited struct {
+	Reset time.Time
+}
+
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("httpcache: rate limited until %s", e.Reset.Format(time.RFC3339))
+}
+
+type entry struct {
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"last_modified"`
+	StatusCode   int       `json:"status_code"`
+	Body         []byte    `json:"body"`
+	StoredAt     time.Time `json:"stored_at"`
+}
+
+// Stats are the cumulative cache-effectiveness counters exposed by
+// Cache.Stats, so a caller can report how much GitHub API quota caching
+// saved.
+type Stats struct {
+	Hits          int64 // served entirely from disk, no request made (fresh TTL entry)
+	Revalidations int64 // request made, server returned 304, cached body reused
+	Misses        int64 // request made, server returned a fresh body
+}
+
+// Cache is an on-disk HTTP response cache keyed by request identity.
+type Cache struct {
+	dir string
+	ttl time.Duration
+
+	hits          atomic.Int64
+	revalidations atomic.Int64
+	misses        atomic.Int64
+}
+
+// New creates a Cache rooted at $XDG_CACHE_HOME/daily-go (falling back to
+// ~/.cache/daily-go), creating the directory if needed. Entries without an
+// ETag/Last-Modified are served from disk for up to defaultTTL before being
+// revalidated.
+func New() (*Cache, error) {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("httpcache: failed to resolve cache dir: %w", err)
+		}
+		base = filepath.Join(home, ".cache")
+	}
+
+	dir := filepath.Join(base, "daily-go")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("httpcache: failed to create cache dir: %w", err)
+	}
+
+	return &Cache{dir: dir, ttl: defaultTTL}, nil
+}
+
+// Stats returns the cumulative cache-hit/miss counters for this Cache.
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:          c.hits.Load(),
+		Revalidations: c.revalidations.Load(),
+		Misses:        c.misses.Load(),
+	}
+}
+
+func (c *Cache) keyFor(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.String() + " " + req.Header.Get("Authorization")))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+func (c *Cache) load(key string) (*entry, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+
+	return &e, true
+}
+
+func (c *Cache) store(key string, e *entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path(key), data, 0o644)
+}
+
+// checkRateLimit inspects GitHub's rate limit headers and returns
+// ErrRateLimited if the current request was rejected for being over the
+// primary quota. X-RateLimit-Remaining: 0 on its own isn't an error — GitHub
+// sets it on the response that legitimately consumes the last unit of quota,
+// so this must only be consulted on a non-2xx/304 status, never on a
+// response that already carries a usable body.
+func checkRateLimit(status int, header http.Header) error {
+	if status != http.StatusForbidden && status != http.StatusTooManyRequests {
+		return nil
+	}
+
+	remaining := header.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(remaining)
+	if err != nil || n > 0 {
+		return nil
+	}
+
+	reset := time.Now().Add(time.Hour)
+	if resetStr := header.Get("X-RateLimit-Reset"); resetStr != "" {
+		if epoch, err := strconv.ParseInt(resetStr, 10, 64); err == nil {
+			reset = time.Unix(epoch, 0)
+		}
+	}
+
+	return &ErrRateLimited{Reset: reset}
+}
+
+// Do performs req through client, transparently adding conditional-request
+// headers from any cached entry and reusing the cached body on a 304. A 200
+// response overwrites the cache entry. Returns ErrRateLimited, along with the
+// response, when GitHub rejects the request (403/429) because the primary
+// quota is exhausted; no rate-limit state is kept between calls, so the
+// request is always made first.
+func (c *Cache) Do(client *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	key := c.keyFor(req)
+	cached, hasCached := c.load(key)
+
+	if hasCached {
+		// An entry with no ETag/Last-Modified can't be conditionally
+		// revalidated (the endpoint doesn't return one), so instead trust it
+		// for a bounded TTL to still avoid spending quota on every call.
+		if cached.ETag == "" && cached.LastModified == "" && time.Since(cached.StoredAt) < c.ttl {
+			c.hits.Add(1)
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, cached.Body, nil
+		}
+
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if err := checkRateLimit(res.StatusCode, res.Header); err != nil {
+		return res, nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && hasCached {
+		c.revalidations.Add(1)
+		return res, cached.Body, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("httpcache: failed to read response: %w", err)
+	}
+
+	if res.StatusCode == http.StatusOK {
+		c.misses.Add(1)
+		_ = c.store(key, &entry{
+			ETag:         res.Header.Get("ETag"),
+			LastModified: res.Header.Get("Last-Modified"),
+			StatusCode:   res.StatusCode,
+			Body:         body,
+			StoredAt:     time.Now(),
+		})
+	}
+
+	return res, body, nil
+}
@@ -1,101 +1,258 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
+	"os"
 	"strings"
+	"text/template"
 	"time"
+
+	"github.com/RafaZeero/daily-go/internal/forge"
 )
 
-const geminiAPIURL = "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash:generateContent?key="
+//go:embed templates/standup.tmpl
+var templatesFS embed.FS
+
+// defaultPromptTemplateName is the name the built-in template is parsed
+// under. A custom template from PromptTemplatePath is parsed under the same
+// name, so template error messages name it rather than the custom file.
+const defaultPromptTemplateName = "standup.tmpl"
+
+// LLM generates a natural-language summary from a prompt already assembled
+// by the caller (see BuildSummaryPrompt), keeping prompt construction
+// independent of which backend answers it.
+type LLM interface {
+	GenerateSummary(ctx context.Context, prompt string) (string, error)
+}
 
-type LLMService struct {
-	apiKey string
+// StreamingLLM is implemented by providers that can stream the summary as
+// it's generated. Callers should type-assert for it rather than assume
+// every LLM supports it, and fall back to GenerateSummary otherwise.
+type StreamingLLM interface {
+	StreamSummary(ctx context.Context, prompt string, tokens chan<- string) error
 }
 
-func NewLLMService(apiKey string) *LLMService {
-	return &LLMService{apiKey: apiKey}
+// ErrRateLimited is returned by a provider when it has rejected a request
+// for being over its rate limit, so the TUI can show a meaningful message
+// instead of a generic failure.
+type ErrRateLimited struct {
+	Provider   string
+	RetryAfter time.Duration
 }
 
-func (llm *LLMService) GenerateSummary(commits []Commit) (string, error) {
-	if len(commits) == 0 {
-		return "No commits found in the specified time period.", nil
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("%s: rate limited, retry after %s", e.Provider, e.RetryAfter)
+}
+
+// withRetry calls fn up to attempts times, backing off exponentially between
+// tries when it fails with ErrRateLimited. Any other error returns
+// immediately without retrying.
+func withRetry(attempts int, fn func() (string, error)) (string, error) {
+	var lastErr error
+
+	backoff := time.Second
+	for i := 0; i < attempts; i++ {
+		result, err := fn()
+		if err == nil {
+			return result, nil
+		}
+
+		lastErr = err
+
+		var rateLimited *ErrRateLimited
+		if !errors.As(err, &rateLimited) {
+			return "", err
+		}
+
+		if i < attempts-1 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
 	}
 
-	// Create a structured summary of commits
-	var commitDetails strings.Builder
-	commitDetails.WriteString("Recent commits summary:\n\n")
+	return "", lastErr
+}
 
-	// Group by repository
-	repoCommits := make(map[string][]Commit)
-	for _, commit := range commits {
-		repoCommits[commit.RepoName] = append(repoCommits[commit.RepoName], commit)
+// NewLLM builds the configured LLM backend from config.
+func NewLLM(config *Config) (LLM, error) {
+	switch config.LLMProvider {
+	case "gemini", "":
+		return NewGeminiProvider(config.GeminiKey, config.LLMModel), nil
+	case "openai":
+		return NewOpenAIProvider(config.OpenAIKey, config.LLMModel), nil
+	case "anthropic":
+		return NewAnthropicProvider(config.AnthropicKey, config.LLMModel), nil
+	case "ollama":
+		return NewOllamaProvider(config.OllamaBaseURL, config.LLMModel), nil
+	default:
+		return nil, fmt.Errorf("llm: unknown provider %q", config.LLMProvider)
 	}
+}
 
-	for repoName, repoCommits := range repoCommits {
-		commitDetails.WriteString(fmt.Sprintf("Repository: %s\n", repoName))
-		for _, commit := range repoCommits {
-			commitDetails.WriteString(fmt.Sprintf("- %s: %s (by %s on %s)\n",
-				commit.SHA, commit.Message, commit.Author, commit.Date.Format("2006-01-02 15:04")))
-		}
-		commitDetails.WriteString("\n")
+// commitType is a Conventional Commits category, used to group commits in
+// the prompt so the LLM doesn't have to infer structure from raw messages.
+type commitType string
+
+const (
+	typeBreaking commitType = "Breaking"
+	typeFeature  commitType = "Features"
+	typeFix      commitType = "Fixes"
+	typeOther    commitType = "Other"
+)
+
+// typeOrder controls the order groups appear in the prompt, breaking
+// changes first since they're the most likely to need a standup callout.
+var typeOrder = []commitType{typeBreaking, typeFeature, typeFix, typeOther}
+
+// classifyCommit inspects a commit message for a Conventional Commits prefix
+// (feat:, fix:, BREAKING CHANGE:, or a "!" marking a breaking change) and
+// returns which summary group it belongs in.
+func classifyCommit(message string) commitType {
+	if strings.Contains(message, "BREAKING CHANGE:") {
+		return typeBreaking
 	}
 
-	// Create prompt for LLM
-	prompt := fmt.Sprintf(`Please provide a concise summary of the following recent commits for a daily standup or meeting. 
-Focus on the most important changes, new features, bug fixes, and any breaking changes. 
-Group by repository and highlight key achievements:
+	head := strings.ToLower(strings.SplitN(message, "\n", 2)[0])
+	if strings.Contains(head, "!:") {
+		return typeBreaking
+	}
 
-%s
+	switch {
+	case strings.HasPrefix(head, "feat:"), strings.HasPrefix(head, "feat("):
+		return typeFeature
+	case strings.HasPrefix(head, "fix:"), strings.HasPrefix(head, "fix("):
+		return typeFix
+	default:
+		return typeOther
+	}
+}
 
-Please format the response as a professional summary suitable for a team meeting.`, commitDetails.String())
+// promptTemplateData is the data available to a prompt template: just the
+// pre-formatted activity breakdown, so a custom template can control tone
+// and framing around it without having to re-derive the breakdown itself.
+type promptTemplateData struct {
+	Details string
+}
 
-	// Call Gemini API
-	requestBody := GeminiRequest{
-		Contents: []GeminiContent{
-			{
-				Parts: []GeminiPart{
-					{Text: prompt},
-				},
-			},
-		},
+// loadPromptTemplate parses the prompt template a custom summary should be
+// built from. An empty path uses the built-in standup.tmpl; otherwise the
+// file at path is parsed, letting users change tone/framing (e.g. more
+// casual, a different section order) without recompiling.
+func loadPromptTemplate(path string) (*template.Template, error) {
+	if path == "" {
+		return template.ParseFS(templatesFS, "templates/"+defaultPromptTemplateName)
 	}
 
-	jsonData, err := json.Marshal(requestBody)
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("llm: failed to read prompt template %q: %w", path, err)
 	}
 
-	url := geminiAPIURL + llm.apiKey
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(jsonData)))
+	tmpl, err := template.New(defaultPromptTemplateName).Parse(string(data))
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("llm: failed to parse prompt template %q: %w", path, err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	return tmpl, nil
+}
 
-	client := http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
+// BuildSummaryPrompt assembles the prompt sent to the configured LLM,
+// organizing activity into the three buckets the prompt asks it to
+// distinguish between: work that shipped, work still in review, and work
+// opened this week. templatePath, if non-empty, overrides the built-in
+// prompt template so users can customize tone without recompiling.
+func BuildSummaryPrompt(activity Activity, templatePath string) (string, error) {
+	if len(activity.Commits) == 0 && len(activity.Issues) == 0 && len(activity.PullRequests) == 0 && len(activity.Reviews) == 0 {
+		return "", nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
+	var details strings.Builder
+
+	details.WriteString("Shipped (commits):\n\n")
+	forgeRepoCommits := make(map[forge.Kind]map[string][]Commit)
+	var forgeOrder []forge.Kind
+	for _, commit := range activity.Commits {
+		repoCommits, ok := forgeRepoCommits[commit.ForgeName]
+		if !ok {
+			repoCommits = make(map[string][]Commit)
+			forgeRepoCommits[commit.ForgeName] = repoCommits
+			forgeOrder = append(forgeOrder, commit.ForgeName)
+		}
+		repoCommits[commit.RepoName] = append(repoCommits[commit.RepoName], commit)
+	}
+	for _, forgeName := range forgeOrder {
+		details.WriteString(fmt.Sprintf("Forge: %s\n", forgeName))
+		for repoName, repoCommits := range forgeRepoCommits[forgeName] {
+			var additions, deletions, files int
+			for _, commit := range repoCommits {
+				additions += commit.Additions
+				deletions += commit.Deletions
+				files += len(commit.Files)
+			}
+			details.WriteString(fmt.Sprintf("Repository: %s (+%d/-%d lines across %d files)\n", repoName, additions, deletions, files))
+			for _, commit := range repoCommits {
+				details.WriteString(fmt.Sprintf("- %s: %s (by %s on %s)\n",
+					commit.SHA, commit.Message, commit.Author, commit.Date.Format("2006-01-02 15:04")))
+			}
+			details.WriteString("\n")
+		}
 	}
 
-	var geminiResp GeminiResponse
-	if err := json.Unmarshal(body, &geminiResp); err != nil {
+	messagesByType := make(map[commitType][]string)
+	for _, commit := range activity.Commits {
+		t := classifyCommit(commit.Message)
+		messagesByType[t] = append(messagesByType[t], fmt.Sprintf("[%s/%s] %s", commit.ForgeName, commit.RepoName, commit.Message))
+	}
+	details.WriteString("By type:\n\n")
+	for _, t := range typeOrder {
+		messages := messagesByType[t]
+		if len(messages) == 0 {
+			continue
+		}
+		details.WriteString(fmt.Sprintf("%s:\n", t))
+		for _, message := range messages {
+			details.WriteString(fmt.Sprintf("- %s\n", message))
+		}
+		details.WriteString("\n")
+	}
+
+	details.WriteString("Pull requests (open, merged or closed):\n\n")
+	for _, pr := range activity.PullRequests {
+		status := "open"
+		if pr.Merged {
+			status = "merged"
+		} else if pr.State == "closed" {
+			status = "closed"
+		}
+		details.WriteString(fmt.Sprintf("- [%s/%s] #%d %s (%s, by %s)\n",
+			pr.ForgeName, pr.RepoName, pr.Number, pr.Title, status, pr.Author))
+	}
+	details.WriteString("\n")
+
+	details.WriteString("In review (reviews left by you):\n\n")
+	for _, r := range activity.Reviews {
+		details.WriteString(fmt.Sprintf("- [%s/%s] review on PR #%d: %s\n", r.ForgeName, r.RepoName, r.PRNumber, r.State))
+	}
+	details.WriteString("\n")
+
+	details.WriteString("Opened this week (issues):\n\n")
+	for _, i := range activity.Issues {
+		details.WriteString(fmt.Sprintf("- [%s/%s] #%d %s (%s, by %s)\n",
+			i.ForgeName, i.RepoName, i.Number, i.Title, i.State, i.Author))
+	}
+
+	tmpl, err := loadPromptTemplate(templatePath)
+	if err != nil {
 		return "", err
 	}
 
-	if len(geminiResp.Candidates) > 0 && len(geminiResp.Candidates[0].Content.Parts) > 0 {
-		return geminiResp.Candidates[0].Content.Parts[0].Text, nil
+	var prompt strings.Builder
+	if err := tmpl.Execute(&prompt, promptTemplateData{Details: details.String()}); err != nil {
+		return "", fmt.Errorf("llm: failed to render prompt template: %w", err)
 	}
 
-	return "Unable to generate summary at this time.", nil
+	return prompt.String(), nil
 }
@@ -1,125 +0,0 @@
-package llm
-
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"os"
-)
-
-type GeminiOptions struct {
-	APIKey string
-	Model  string
-}
-
-type GeminiRequest struct {
-	Contents []Content `json:"contents"`
-}
-
-type Content struct {
-	Parts []Part `json:"parts"`
-}
-
-type Part struct {
-	Text string `json:"text"`
-}
-
-type GeminiResponse struct {
-	Candidates []Candidate `json:"candidates"`
-}
-
-type Candidate struct {
-	Content Content `json:"content"`
-}
-
-type Gemini struct {
-	apiKey string
-	model  string
-	client *http.Client
-}
-
-func NewGemini(options GeminiOptions) *Gemini {
-	model := options.Model
-	if model == "" {
-		model = "gemini-2.0-flash"
-	}
-
-	return &Gemini{
-		apiKey: options.APIKey,
-		model:  model,
-		client: &http.Client{},
-	}
-}
-
-// GenerateContent makes a call to the Gemini API with the provided prompt
-func (g *Gemini) GenerateContent(prompt string) (string, error) {
-	url := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/%s:generateContent?key=%s", g.model, g.apiKey)
-
-	requestBody := GeminiRequest{
-		Contents: []Content{
-			{
-				Parts: []Part{
-					{Text: prompt},
-				},
-			},
-		},
-	}
-
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := g.client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var response GeminiResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return "", fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	if len(response.Candidates) == 0 {
-		return "", fmt.Errorf("no candidates in response")
-	}
-
-	if len(response.Candidates[0].Content.Parts) == 0 {
-		return "", fmt.Errorf("no content parts in response")
-	}
-
-	return response.Candidates[0].Content.Parts[0].Text, nil
-}
-
-// GenerateContentWithEnvKey makes a call to Gemini API using API key from environment variable
-func GenerateContentWithEnvKey(prompt string) (string, error) {
-	apiKey := os.Getenv("GEMINI_API_KEY")
-	if apiKey == "" {
-		return "", fmt.Errorf("GEMINI_API_KEY environment variable not set")
-	}
-
-	gemini := NewGemini(GeminiOptions{
-		APIKey: apiKey,
-	})
-
-	return gemini.GenerateContent(prompt)
-}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAnthropicModel = "claude-3-5-sonnet-latest"
+	defaultAnthropicURL   = "https://api.anthropic.com/v1/messages"
+	anthropicVersion      = "2023-06-01"
+	anthropicMaxTokens    = 1024
+	anthropicRetries      = 3
+)
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+	Stream    bool               `json:"stream,omitempty"`
+}
+
+type anthropicContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type anthropicResponse struct {
+	Content []anthropicContentBlock `json:"content"`
+}
+
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Text string `json:"text"`
+	} `json:"delta"`
+}
+
+// AnthropicProvider talks to Anthropic's Messages API.
+type AnthropicProvider struct {
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+func NewAnthropicProvider(apiKey, model string) *AnthropicProvider {
+	if model == "" {
+		model = defaultAnthropicModel
+	}
+
+	return &AnthropicProvider{
+		apiKey: apiKey,
+		model:  model,
+		client: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (a *AnthropicProvider) newRequest(ctx context.Context, body anthropicRequest) (*http.Request, error) {
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", defaultAnthropicURL, bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", a.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+
+	return req, nil
+}
+
+func (a *AnthropicProvider) GenerateSummary(ctx context.Context, prompt string) (string, error) {
+	return withRetry(anthropicRetries, func() (string, error) {
+		req, err := a.newRequest(ctx, anthropicRequest{
+			Model:     a.model,
+			MaxTokens: anthropicMaxTokens,
+			Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := a.client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return "", &ErrRateLimited{Provider: "anthropic", RetryAfter: retryAfter(resp.Header, time.Minute)}
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("anthropic: request failed with status %d: %s", resp.StatusCode, string(body))
+		}
+
+		var anthropicResp anthropicResponse
+		if err := json.Unmarshal(body, &anthropicResp); err != nil {
+			return "", err
+		}
+
+		if len(anthropicResp.Content) == 0 {
+			return "Unable to generate summary at this time.", nil
+		}
+
+		return anthropicResp.Content[0].Text, nil
+	})
+}
+
+// StreamSummary satisfies StreamingLLM, emitting each text delta from the
+// Anthropic SSE stream onto tokens as it arrives.
+func (a *AnthropicProvider) StreamSummary(ctx context.Context, prompt string, tokens chan<- string) error {
+	req, err := a.newRequest(ctx, anthropicRequest{
+		Model:     a.model,
+		MaxTokens: anthropicMaxTokens,
+		Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+		Stream:    true,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &ErrRateLimited{Provider: "anthropic", RetryAfter: retryAfter(resp.Header, time.Minute)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("anthropic: request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err != nil {
+			continue
+		}
+
+		if event.Type == "content_block_delta" && event.Delta.Text != "" {
+			tokens <- event.Delta.Text
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultGeminiModel = "gemini-2.0-flash"
+	geminiRetries      = 3
+)
+
+// GeminiProvider talks to Google's generateContent REST endpoint.
+type GeminiProvider struct {
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+func NewGeminiProvider(apiKey, model string) *GeminiProvider {
+	if model == "" {
+		model = defaultGeminiModel
+	}
+
+	return &GeminiProvider{
+		apiKey: apiKey,
+		model:  model,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (g *GeminiProvider) GenerateSummary(ctx context.Context, prompt string) (string, error) {
+	return withRetry(geminiRetries, func() (string, error) {
+		url := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/%s:generateContent?key=%s", g.model, g.apiKey)
+
+		requestBody := GeminiRequest{
+			Contents: []GeminiContent{{Parts: []GeminiPart{{Text: prompt}}}},
+		}
+
+		jsonData, err := json.Marshal(requestBody)
+		if err != nil {
+			return "", err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := g.client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return "", &ErrRateLimited{Provider: "gemini", RetryAfter: time.Minute}
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("gemini: request failed with status %d: %s", resp.StatusCode, string(body))
+		}
+
+		var geminiResp GeminiResponse
+		if err := json.Unmarshal(body, &geminiResp); err != nil {
+			return "", err
+		}
+
+		if len(geminiResp.Candidates) > 0 && len(geminiResp.Candidates[0].Content.Parts) > 0 {
+			return geminiResp.Candidates[0].Content.Parts[0].Text, nil
+		}
+
+		return "Unable to generate summary at this time.", nil
+	})
+}
+
+// StreamSummary satisfies StreamingLLM, emitting each candidate text chunk
+// from Gemini's SSE streamGenerateContent endpoint onto tokens as it
+// arrives, so the default provider renders progressively like the rest.
+func (g *GeminiProvider) StreamSummary(ctx context.Context, prompt string, tokens chan<- string) error {
+	url := fmt.Sprintf("https://generativelanguage.googleapis.com/v1beta/models/%s:streamGenerateContent?alt=sse&key=%s", g.model, g.apiKey)
+
+	requestBody := GeminiRequest{
+		Contents: []GeminiContent{{Parts: []GeminiPart{{Text: prompt}}}},
+	}
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &ErrRateLimited{Provider: "gemini", RetryAfter: time.Minute}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("gemini: request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		var chunk GeminiResponse
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &chunk); err != nil {
+			continue
+		}
+
+		if len(chunk.Candidates) > 0 && len(chunk.Candidates[0].Content.Parts) > 0 {
+			tokens <- chunk.Candidates[0].Content.Parts[0].Text
+		}
+	}
+
+	return scanner.Err()
+}
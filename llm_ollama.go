@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultOllamaBaseURL = "http://localhost:11434"
+	defaultOllamaModel   = "llama3"
+)
+
+type ollamaRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaResponseChunk struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+// OllamaProvider talks to a local (or self-hosted) Ollama server's
+// /api/generate endpoint. Ollama doesn't enforce a rate limit, so there's no
+// ErrRateLimited path here.
+type OllamaProvider struct {
+	baseURL string
+	model   string
+	client  *http.Client
+}
+
+func NewOllamaProvider(baseURL, model string) *OllamaProvider {
+	if baseURL == "" {
+		baseURL = defaultOllamaBaseURL
+	}
+	if model == "" {
+		model = defaultOllamaModel
+	}
+
+	return &OllamaProvider{
+		baseURL: baseURL,
+		model:   model,
+		client:  &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+func (o *OllamaProvider) newRequest(ctx context.Context, stream bool, prompt string) (*http.Request, error) {
+	jsonData, err := json.Marshal(ollamaRequest{Model: o.model, Prompt: prompt, Stream: stream})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.baseURL+"/api/generate", bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+func (o *OllamaProvider) GenerateSummary(ctx context.Context, prompt string) (string, error) {
+	req, err := o.newRequest(ctx, false, prompt)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("ollama: request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var chunk ollamaResponseChunk
+	if err := json.Unmarshal(body, &chunk); err != nil {
+		return "", err
+	}
+
+	return chunk.Response, nil
+}
+
+// StreamSummary satisfies StreamingLLM, emitting each chunk of the
+// newline-delimited JSON response Ollama streams by default onto tokens.
+func (o *OllamaProvider) StreamSummary(ctx context.Context, prompt string, tokens chan<- string) error {
+	req, err := o.newRequest(ctx, true, prompt)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("ollama: request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var chunk ollamaResponseChunk
+		if err := json.Unmarshal(scanner.Bytes(), &chunk); err != nil {
+			continue
+		}
+
+		if chunk.Response != "" {
+			tokens <- chunk.Response
+		}
+		if chunk.Done {
+			break
+		}
+	}
+
+	return scanner.Err()
+}
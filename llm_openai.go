@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOpenAIModel = "gpt-4o-mini"
+	defaultOpenAIURL   = "https://api.openai.com/v1/chat/completions"
+	openAIRetries      = 3
+)
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIRequest struct {
+	Model    string          `json:"model"`
+	Messages []openAIMessage `json:"messages"`
+	Stream   bool            `json:"stream,omitempty"`
+}
+
+type openAIChoice struct {
+	Message openAIMessage `json:"message"`
+}
+
+type openAIResponse struct {
+	Choices []openAIChoice `json:"choices"`
+}
+
+type openAIStreamChoice struct {
+	Delta openAIDelta `json:"delta"`
+}
+
+type openAIDelta struct {
+	Content string `json:"content"`
+}
+
+type openAIStreamChunk struct {
+	Choices []openAIStreamChoice `json:"choices"`
+}
+
+// OpenAIProvider talks to the OpenAI Chat Completions API.
+type OpenAIProvider struct {
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+func NewOpenAIProvider(apiKey, model string) *OpenAIProvider {
+	if model == "" {
+		model = defaultOpenAIModel
+	}
+
+	return &OpenAIProvider{
+		apiKey: apiKey,
+		model:  model,
+		client: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (o *OpenAIProvider) newRequest(ctx context.Context, body openAIRequest) (*http.Request, error) {
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", defaultOpenAIURL, bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", o.apiKey))
+
+	return req, nil
+}
+
+func (o *OpenAIProvider) GenerateSummary(ctx context.Context, prompt string) (string, error) {
+	return withRetry(openAIRetries, func() (string, error) {
+		req, err := o.newRequest(ctx, openAIRequest{
+			Model:    o.model,
+			Messages: []openAIMessage{{Role: "user", Content: prompt}},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := o.client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return "", &ErrRateLimited{Provider: "openai", RetryAfter: retryAfter(resp.Header, time.Minute)}
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("openai: request failed with status %d: %s", resp.StatusCode, string(body))
+		}
+
+		var openAIResp openAIResponse
+		if err := json.Unmarshal(body, &openAIResp); err != nil {
+			return "", err
+		}
+
+		if len(openAIResp.Choices) == 0 {
+			return "Unable to generate summary at this time.", nil
+		}
+
+		return openAIResp.Choices[0].Message.Content, nil
+	})
+}
+
+// StreamSummary satisfies StreamingLLM, emitting each content delta from the
+// OpenAI SSE stream onto tokens as it arrives.
+func (o *OpenAIProvider) StreamSummary(ctx context.Context, prompt string, tokens chan<- string) error {
+	req, err := o.newRequest(ctx, openAIRequest{
+		Model:    o.model,
+		Messages: []openAIMessage{{Role: "user", Content: prompt}},
+		Stream:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &ErrRateLimited{Provider: "openai", RetryAfter: retryAfter(resp.Header, time.Minute)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("openai: request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		data := strings.TrimPrefix(line, "data: ")
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			continue
+		}
+
+		if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+			tokens <- chunk.Choices[0].Delta.Content
+		}
+	}
+
+	return scanner.Err()
+}
+
+// retryAfter reads the Retry-After header (seconds) falling back to def.
+func retryAfter(header http.Header, def time.Duration) time.Duration {
+	if s := header.Get("Retry-After"); s != "" {
+		if secs, err := time.ParseDuration(s + "s"); err == nil {
+			return secs
+		}
+	}
+	return def
+}
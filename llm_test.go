@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestClassifyCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    commitType
+	}{
+		{"feat prefix", "feat: add dark mode", typeFeature},
+		{"feat with scope", "feat(auth): support SSO", typeFeature},
+		{"fix prefix", "fix: correct off-by-one in paginator", typeFix},
+		{"fix with scope", "fix(cache): handle missing ETag", typeFix},
+		{"bang marks breaking", "feat!: drop support for Go 1.20", typeBreaking},
+		{"breaking change footer", "fix: rename config field\n\nBREAKING CHANGE: Forges renamed to ForgeConfigs", typeBreaking},
+		{"unrecognized prefix", "docs: update README", typeOther},
+		{"no prefix at all", "quick hack before lunch", typeOther},
+		{"case insensitive prefix", "Fix: correct typo", typeFix},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyCommit(tt.message); got != tt.want {
+				t.Errorf("classifyCommit(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
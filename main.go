@@ -1,14 +1,13 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/joho/godotenv"
 
@@ -16,352 +15,123 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-)
 
-const (
-	githubAPIBaseURL = "https://api.github.com"
-	geminiAPIURL     = "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash:generateContent?key="
+	"github.com/RafaZeero/daily-go/internal/forge"
+	"github.com/RafaZeero/daily-go/internal/httpcache"
 )
 
-type Repo struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Private   bool      `json:"private"`
-	HTMLURL   string    `json:"html_url"`
-	URL       string    `json:"url"`
-	Language  string    `json:"language"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
-
-type Commit struct {
-	SHA      string    `json:"sha"`
-	Message  string    `json:"commit"`
-	Author   string    `json:"author"`
-	Date     time.Time `json:"date"`
-	RepoName string    `json:"repo_name"`
-	HTMLURL  string    `json:"html_url"`
-}
-
-type CommitMessage struct {
-	Message string `json:"message"`
-}
-
-type CommitAuthor struct {
-	Name  string `json:"name"`
-	Email string `json:"email"`
-	Date  string `json:"date"`
-}
-
-type CommitDetails struct {
-	Message CommitMessage `json:"message"`
-	Author  CommitAuthor  `json:"author"`
-}
-
-type CommitResponse struct {
-	SHA     string        `json:"sha"`
-	Commit  CommitDetails `json:"commit"`
-	HTMLURL string        `json:"html_url"`
-	Author  *struct {
-		Login string `json:"login"`
-	} `json:"author"`
-}
-
-type GeminiRequest struct {
-	Contents []GeminiContent `json:"contents"`
-}
-
-type GeminiContent struct {
-	Parts []GeminiPart `json:"parts"`
-}
-
-type GeminiPart struct {
-	Text string `json:"text"`
-}
-
-type GeminiResponse struct {
-	Candidates []GeminiCandidate `json:"candidates"`
-}
-
-type GeminiCandidate struct {
-	Content GeminiContent `json:"content"`
-}
-
-type GeminiPartResponse struct {
-	Text string `json:"text"`
-}
-
-func (r Repo) String() string {
-	return fmt.Sprintf("%s (%s) - Updated: %s", r.Name, r.Language, r.UpdatedAt.Format("2006-01-02"))
-}
-
-type user struct {
-	username string
-	apiKey   string
-}
-
-type GitHub struct {
-	user  user
-	repos []Repo
-}
-
-type GitHubOptions struct {
-	APIKey   string
-	Username string
-}
-
-func NewGithub(opts GitHubOptions) *GitHub {
-	if opts.APIKey == "" {
-		log.Fatal("authorization token should not be empty")
-	}
-
-	if opts.Username == "" {
-		log.Fatal("user should not be empty")
-	}
-
-	gh := &GitHub{
-		user: user{
-			username: opts.Username,
-			apiKey:   opts.APIKey,
-		},
-		repos: make([]Repo, 0),
-	}
-
-	gh.LoadReposFromUser()
-
-	return gh
+type repoSelectionMsg struct {
+	repos []string
+	err   error
 }
 
-func (gh *GitHub) LoadReposFromUser() {
-	url := fmt.Sprintf("%s/users/%s/repos", githubAPIBaseURL, gh.user.username)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Fatal("failed to create request")
-		return
-	}
-
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", gh.user.apiKey))
-	req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
-	req.Header.Add("Accept", "application/vnd.github+json")
-
-	client := http.Client{Timeout: 15 * time.Second}
-
-	res, err := client.Do(req)
-	if err != nil {
-		log.Fatal("failed to do request")
-		return
-	}
-
-	defer res.Body.Close()
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		log.Fatal("failed to read data")
-		return
-	}
-
-	var Repos []Repo
-	if err := json.Unmarshal(body, &Repos); err != nil {
-		log.Fatal("failed to unmarshal data")
-		return
+func SelectedRepos(repos []string) tea.Cmd {
+	return func() tea.Msg {
+		return repoSelectionMsg{repos: repos}
 	}
-
-	gh.repos = append(gh.repos, Repos...)
 }
 
-func (gh *GitHub) GetRepos() []Repo {
-	return gh.repos
+type activityLoadedMsg struct {
+	activity Activity
+	err      error
 }
 
-func (gh *GitHub) GetReposChoices() []string {
-	choices := []string{}
-	for _, r := range gh.GetRepos() {
-		choices = append(choices, fmt.Sprint(r))
+func LoadActivity(forges *Forges, repos []string, daysBack int) tea.Cmd {
+	return func() tea.Msg {
+		activity, err := forges.GetActivitySince(context.Background(), repos, daysBack)
+		return activityLoadedMsg{activity: activity, err: err}
 	}
-	return choices
 }
 
-func (gh *GitHub) GetLatestCommits(repoNames []string, daysBack int) ([]Commit, error) {
-	var allCommits []Commit
-	since := time.Now().AddDate(0, 0, -daysBack)
-
-	for _, repoName := range repoNames {
-		// Extract repo name from the choice string
-		name := strings.Split(repoName, " (")[0]
-
-		url := fmt.Sprintf("%s/repos/%s/%s/commits?since=%s",
-			githubAPIBaseURL, gh.user.username, name, since.Format(time.RFC3339))
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			continue
-		}
-
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", gh.user.apiKey))
-		req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
-		req.Header.Add("Accept", "application/vnd.github+json")
-
-		client := http.Client{Timeout: 15 * time.Second}
-
-		res, err := client.Do(req)
-		if err != nil {
-			continue
-		}
-
-		defer res.Body.Close()
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			continue
-		}
-
-		var commits []CommitResponse
-		if err := json.Unmarshal(body, &commits); err != nil {
-			continue
-		}
-
-		for _, c := range commits {
-			date, _ := time.Parse(time.RFC3339, c.Commit.Author.Date)
-			author := c.Commit.Author.Name
-			if c.Author != nil {
-				author = c.Author.Login
-			}
+// fetchProgressMsg wraps a forge.Page fetch-progress event for the TUI.
+type fetchProgressMsg forge.Page
 
-			commit := Commit{
-				SHA:      c.SHA[:8],
-				Message:  c.Commit.Message.Message,
-				Author:   author,
-				Date:     date,
-				RepoName: name,
-				HTMLURL:  c.HTMLURL,
-			}
-			allCommits = append(allCommits, commit)
+// listenForProgress waits for the next fetch-progress event on the
+// forges' progress channel and turns it into a tea.Msg. Update
+// re-issues this command after every event so the spinner view keeps
+// receiving updates for the remainder of the fetch.
+func listenForProgress(forges *Forges) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-forges.Progress()
+		if !ok {
+			return nil
 		}
+		return fetchProgressMsg(p)
 	}
-
-	return allCommits, nil
 }
 
-type LLMService struct {
-	apiKey string
+// summaryChunkMsg carries one piece of the summary as it streams in (or a
+// terminal error) so the view can render it progressively instead of
+// blocking on the full response.
+type summaryChunkMsg struct {
+	text string
+	err  error
 }
 
-func NewLLMService(apiKey string) *LLMService {
-	return &LLMService{apiKey: apiKey}
-}
-
-func (llm *LLMService) GenerateSummary(commits []Commit) (string, error) {
-	if len(commits) == 0 {
-		return "No commits found in the specified time period.", nil
-	}
-
-	// Create a structured summary of commits
-	var commitDetails strings.Builder
-	commitDetails.WriteString("Recent commits summary:\n\n")
-
-	// Group by repository
-	repoCommits := make(map[string][]Commit)
-	for _, commit := range commits {
-		repoCommits[commit.RepoName] = append(repoCommits[commit.RepoName], commit)
-	}
-
-	for repoName, repoCommits := range repoCommits {
-		commitDetails.WriteString(fmt.Sprintf("Repository: %s\n", repoName))
-		for _, commit := range repoCommits {
-			commitDetails.WriteString(fmt.Sprintf("- %s: %s (by %s on %s)\n",
-				commit.SHA, commit.Message, commit.Author, commit.Date.Format("2006-01-02 15:04")))
-		}
-		commitDetails.WriteString("\n")
-	}
-
-	// Create prompt for LLM
-	prompt := fmt.Sprintf(`Please provide a concise summary of the following recent commits for a daily standup or meeting. 
-Focus on the most important changes, new features, bug fixes, and any breaking changes. 
-Group by repository and highlight key achievements:
+// summaryDoneMsg signals the summary stream has finished successfully.
+type summaryDoneMsg struct{}
 
-%s
+// StreamSummary kicks off summary generation in the background and returns
+// the tea.Cmd that starts it plus the channel it streams summaryChunkMsg
+// onto. If llm implements StreamingLLM, chunks arrive as the backend
+// produces them; otherwise the whole summary arrives as a single chunk.
+func StreamSummary(llm LLM, activity Activity, promptTemplatePath string) (tea.Cmd, chan summaryChunkMsg) {
+	chunks := make(chan summaryChunkMsg)
 
-Please format the response as a professional summary suitable for a team meeting.`, commitDetails.String())
+	cmd := func() tea.Msg {
+		go func() {
+			defer close(chunks)
 
-	// Call Gemini API
-	requestBody := GeminiRequest{
-		Contents: []GeminiContent{
-			{
-				Parts: []GeminiPart{
-					{Text: prompt},
-				},
-			},
-		},
-	}
+			ctx := context.Background()
 
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return "", err
-	}
-
-	url := geminiAPIURL + llm.apiKey
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(jsonData)))
-	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	client := http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	var geminiResp GeminiResponse
-	if err := json.Unmarshal(body, &geminiResp); err != nil {
-		return "", err
-	}
-
-	if len(geminiResp.Candidates) > 0 && len(geminiResp.Candidates[0].Content.Parts) > 0 {
-		return geminiResp.Candidates[0].Content.Parts[0].Text, nil
-	}
-
-	return "Unable to generate summary at this time.", nil
-}
+			prompt, err := BuildSummaryPrompt(activity, promptTemplatePath)
+			if err != nil {
+				chunks <- summaryChunkMsg{err: err}
+				return
+			}
+			if prompt == "" {
+				chunks <- summaryChunkMsg{text: "No activity found in the specified time period."}
+				return
+			}
 
-type repoSelectionMsg struct {
-	repos []string
-	err   error
-}
+			streamer, ok := llm.(StreamingLLM)
+			if !ok {
+				summary, err := llm.GenerateSummary(ctx, prompt)
+				chunks <- summaryChunkMsg{text: summary, err: err}
+				return
+			}
 
-func SelectedRepos(repos []string) tea.Cmd {
-	return func() tea.Msg {
-		return repoSelectionMsg{repos: repos}
-	}
-}
+			tokens := make(chan string)
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- streamer.StreamSummary(ctx, prompt, tokens)
+				close(tokens)
+			}()
 
-type commitsLoadedMsg struct {
-	commits []Commit
-	err     error
-}
+			for token := range tokens {
+				chunks <- summaryChunkMsg{text: token}
+			}
+			if err := <-errCh; err != nil {
+				chunks <- summaryChunkMsg{err: err}
+			}
+		}()
 
-func LoadCommits(gh *GitHub, repos []string, daysBack int) tea.Cmd {
-	return func() tea.Msg {
-		commits, err := gh.GetLatestCommits(repos, daysBack)
-		return commitsLoadedMsg{commits: commits, err: err}
+		return nil
 	}
-}
 
-type summaryGeneratedMsg struct {
-	summary string
-	err     error
+	return cmd, chunks
 }
 
-func GenerateSummary(llm *LLMService, commits []Commit) tea.Cmd {
+// listenForSummaryChunk waits for the next chunk on chunks and turns it into
+// a tea.Msg. Update re-issues this command after every chunk so the view
+// keeps receiving updates for the remainder of the stream.
+func listenForSummaryChunk(chunks chan summaryChunkMsg) tea.Cmd {
 	return func() tea.Msg {
-		summary, err := llm.GenerateSummary(commits)
-		return summaryGeneratedMsg{summary: summary, err: err}
+		chunk, ok := <-chunks
+		if !ok {
+			return summaryDoneMsg{}
+		}
+		return chunk
 	}
 }
 
@@ -371,24 +141,60 @@ const (
 	ACTION__SHOW_ALL_REPOS Action = iota
 	ACTION__SHOW_SELECTED_REPOS
 	ACTION__LOADING_COMMITS
-	ACTION__SHOW_COMMITS
+	ACTION__SHOW_ACTIVITY
 	ACTION__GENERATING_SUMMARY
 	ACTION__SHOW_SUMMARY
+	ACTION__EXPORT
 )
 
 type model struct {
-	choices      []string
-	cursor       int
-	selected     map[int]struct{}
-	paginator    paginator.Model
-	repoSelected []string
-	action       Action
-	spinner      spinner.Model
-	commits      []Commit
-	summary      string
-	gh           *GitHub
-	llm          *LLMService
-	daysBack     int
+	choices            []string
+	cursor             int
+	selected           map[int]struct{}
+	paginator          paginator.Model
+	repoSelected       []string
+	action             Action
+	spinner            spinner.Model
+	activity           Activity
+	summary            string
+	summaryChunks      chan summaryChunkMsg
+	exportStatus       string
+	forges             *Forges
+	llm                LLM
+	daysBack           int
+	fetchStatus        string
+	promptTemplatePath string
+	errStatus          string
+}
+
+// exportResultText formats the status line shown after an export attempt.
+func exportResultText(kind, path string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("Failed to export %s: %v", kind, err)
+	}
+	return fmt.Sprintf("Exported %s to %s", kind, path)
+}
+
+// errStatusText formats err for the status line shown after a failed fetch
+// or summary generation, calling out a rate limit by name (and when it'll
+// clear) instead of the generic "request failed" a bare %v would give.
+func errStatusText(err error) string {
+	var forgeRL *forge.RateLimitError
+	if errors.As(err, &forgeRL) {
+		return fmt.Sprintf("Rate limited, retry after %s", forgeRL.RetryAfter)
+	}
+
+	var cacheRL *httpcache.ErrRateLimited
+	if errors.As(err, &cacheRL) {
+		return fmt.Sprintf("Rate limited until %s", cacheRL.Reset.Format("15:04:05"))
+	}
+
+	var llmRL *ErrRateLimited
+	if errors.As(err, &llmRL) {
+		return fmt.Sprintf("%s rate limited, retry after %s", llmRL.Provider, llmRL.RetryAfter)
+	}
+
+	return fmt.Sprintf("Error: %v", err)
 }
 
 func (m model) Init() tea.Cmd {
@@ -447,16 +253,42 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if len(selected) > 0 {
 					m.repoSelected = selected
 					m.action = ACTION__LOADING_COMMITS
-					return m, LoadCommits(m.gh, selected, m.daysBack)
+					return m, tea.Batch(LoadActivity(m.forges, selected, m.daysBack), listenForProgress(m.forges))
 				}
 			} else if m.action == ACTION__SHOW_SELECTED_REPOS {
 				m.action = ACTION__LOADING_COMMITS
-				return m, LoadCommits(m.gh, m.repoSelected, m.daysBack)
-			} else if m.action == ACTION__SHOW_COMMITS {
+				return m, tea.Batch(LoadActivity(m.forges, m.repoSelected, m.daysBack), listenForProgress(m.forges))
+			} else if m.action == ACTION__SHOW_ACTIVITY {
 				m.action = ACTION__GENERATING_SUMMARY
-				return m, GenerateSummary(m.llm, m.commits)
+				m.summary = ""
+				cmd, chunks := StreamSummary(m.llm, m.activity, m.promptTemplatePath)
+				m.summaryChunks = chunks
+				return m, tea.Batch(cmd, listenForSummaryChunk(chunks))
 			} else if m.action == ACTION__SHOW_SUMMARY {
-				return m, tea.Quit
+				m.action = ACTION__EXPORT
+			}
+
+		case "m", "x", "s", "c":
+			if m.action != ACTION__EXPORT {
+				break
+			}
+
+			switch msg.String() {
+			case "m":
+				path, err := ExportMarkdown(m.activity, m.summary)
+				m.exportStatus = exportResultText("markdown", path, err)
+			case "x":
+				path, err := ExportJSON(m.activity, m.summary)
+				m.exportStatus = exportResultText("json", path, err)
+			case "s":
+				path, err := ExportSlackBlocks(m.summary)
+				m.exportStatus = exportResultText("slack", path, err)
+			case "c":
+				if err := CopyToClipboard(m.summary); err != nil {
+					m.exportStatus = fmt.Sprintf("Failed to copy summary to clipboard: %v", err)
+				} else {
+					m.exportStatus = "Copied summary to clipboard"
+				}
 			}
 		}
 
@@ -464,20 +296,35 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.action = ACTION__SHOW_SELECTED_REPOS
 		m.repoSelected = msg.repos
 
-	case commitsLoadedMsg:
+	case fetchProgressMsg:
+		if msg.Done {
+			m.fetchStatus = fmt.Sprintf("Loaded %d items from %s", msg.Loaded, msg.Repo)
+		} else {
+			m.fetchStatus = fmt.Sprintf("Loaded %d items from %s (page %d)", msg.Loaded, msg.Repo, msg.Page)
+		}
+		return m, listenForProgress(m.forges)
+
+	case activityLoadedMsg:
 		if msg.err != nil {
+			m.errStatus = errStatusText(msg.err)
 			m.action = ACTION__SHOW_SELECTED_REPOS
 			return m, nil
 		}
-		m.commits = msg.commits
-		m.action = ACTION__SHOW_COMMITS
+		m.errStatus = ""
+		m.activity = msg.activity
+		m.action = ACTION__SHOW_ACTIVITY
+		m.fetchStatus = ""
 
-	case summaryGeneratedMsg:
+	case summaryChunkMsg:
 		if msg.err != nil {
-			m.action = ACTION__SHOW_COMMITS
+			m.errStatus = errStatusText(msg.err)
+			m.action = ACTION__SHOW_ACTIVITY
 			return m, nil
 		}
-		m.summary = msg.summary
+		m.summary += msg.text
+		return m, listenForSummaryChunk(m.summaryChunks)
+
+	case summaryDoneMsg:
 		m.action = ACTION__SHOW_SUMMARY
 
 	case spinner.TickMsg:
@@ -523,20 +370,26 @@ func (m model) View() string {
 			viewText.WriteString(fmt.Sprintf("â€¢ %s\n", repo))
 		}
 		viewText.WriteString(fmt.Sprintf("\nAnalyzing commits from the last %d days...\n", m.daysBack))
+		if m.errStatus != "" {
+			viewText.WriteString(m.errStatus + "\n")
+		}
 		viewText.WriteString("Press enter to continue or q to quit.\n")
 
 	case ACTION__LOADING_COMMITS:
 		viewText.WriteString(fmt.Sprintf("%s Loading commits from selected repositories...\n", m.spinner.View()))
+		if m.fetchStatus != "" {
+			viewText.WriteString(m.fetchStatus + "\n")
+		}
 		viewText.WriteString("This may take a moment...\n")
 
-	case ACTION__SHOW_COMMITS:
-		viewText.WriteString("Recent commits found:\n\n")
+	case ACTION__SHOW_ACTIVITY:
+		viewText.WriteString("Recent activity found:\n\n")
 
-		if len(m.commits) == 0 {
-			viewText.WriteString("No commits found in the specified time period.\n")
+		if len(m.activity.Commits) == 0 && len(m.activity.Issues) == 0 && len(m.activity.PullRequests) == 0 && len(m.activity.Reviews) == 0 {
+			viewText.WriteString("No activity found in the specified time period.\n")
 		} else {
 			repoCommits := make(map[string][]Commit)
-			for _, commit := range m.commits {
+			for _, commit := range m.activity.Commits {
 				repoCommits[commit.RepoName] = append(repoCommits[commit.RepoName], commit)
 			}
 
@@ -551,37 +404,140 @@ func (m model) View() string {
 				}
 				viewText.WriteString("\n")
 			}
+
+			if len(m.activity.PullRequests) > 0 {
+				viewText.WriteString(fmt.Sprintf("Pull requests (%d):\n", len(m.activity.PullRequests)))
+				for _, pr := range m.activity.PullRequests {
+					viewText.WriteString(fmt.Sprintf("   â€¢ #%d %s (%s)\n", pr.Number, pr.Title, pr.State))
+				}
+				viewText.WriteString("\n")
+			}
+
+			if len(m.activity.Issues) > 0 {
+				viewText.WriteString(fmt.Sprintf("Issues (%d):\n", len(m.activity.Issues)))
+				for _, i := range m.activity.Issues {
+					viewText.WriteString(fmt.Sprintf("   â€¢ #%d %s (%s)\n", i.Number, i.Title, i.State))
+				}
+				viewText.WriteString("\n")
+			}
+
+			if len(m.activity.Reviews) > 0 {
+				viewText.WriteString(fmt.Sprintf("Reviews left by you (%d):\n", len(m.activity.Reviews)))
+				for _, r := range m.activity.Reviews {
+					viewText.WriteString(fmt.Sprintf("   â€¢ PR #%d: %s\n", r.PRNumber, r.State))
+				}
+				viewText.WriteString("\n")
+			}
 		}
 
+		if m.errStatus != "" {
+			viewText.WriteString(m.errStatus + "\n")
+		}
 		viewText.WriteString("Press enter to generate summary or q to quit.\n")
 
 	case ACTION__GENERATING_SUMMARY:
-		viewText.WriteString(fmt.Sprintf("%s Generating AI summary...\n", m.spinner.View()))
-		viewText.WriteString("This may take a moment...\n")
+		viewText.WriteString(fmt.Sprintf("%s Generating AI summary...\n\n", m.spinner.View()))
+		if m.summary != "" {
+			viewText.WriteString(m.summary)
+			viewText.WriteString("\n")
+		}
 
 	case ACTION__SHOW_SUMMARY:
 		viewText.WriteString("ðŸ¤– AI Generated Summary:\n\n")
 		viewText.WriteString(m.summary)
-		viewText.WriteString("\n\nPress enter to exit.\n")
+		viewText.WriteString("\n\nPress enter to export.\n")
+
+	case ACTION__EXPORT:
+		viewText.WriteString("Export the standup summary:\n\n")
+		viewText.WriteString("  m - Markdown (./standup-YYYY-MM-DD.md)\n")
+		viewText.WriteString("  x - JSON (./standup-YYYY-MM-DD.json)\n")
+		viewText.WriteString("  s - Slack Block Kit (./standup-YYYY-MM-DD.slack.json)\n")
+		viewText.WriteString("  c - Copy to clipboard\n\n")
+		if m.exportStatus != "" {
+			viewText.WriteString(m.exportStatus + "\n\n")
+		}
+		viewText.WriteString("Press q to quit.\n")
 	}
 
 	return viewText.String()
 }
 
+// runNonInteractive selects every recently updated repo, generates the
+// standup summary, and writes it to stdout without starting the Bubble Tea
+// UI, so it can be driven from cron for daily Slack posting.
+func runNonInteractive(forges *Forges, llmClient LLM, daysBack int, promptTemplatePath string, showStats bool) {
+	ctx := context.Background()
+
+	choices, err := forges.GetRecentlyUpdatedRepos(ctx, daysBack)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	activity, err := forges.GetActivitySince(ctx, choices, daysBack)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	prompt, err := BuildSummaryPrompt(activity, promptTemplatePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if prompt == "" {
+		fmt.Println("No activity found in the specified time period.")
+		return
+	}
+
+	summary, err := llmClient.GenerateSummary(ctx, prompt)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(summary)
+
+	if showStats {
+		printCacheStats(forges)
+	}
+}
+
+// printCacheStats reports how much GitHub API quota caching saved this run.
+func printCacheStats(forges *Forges) {
+	stats := forges.CacheStats()
+	fmt.Fprintf(os.Stderr, "cache: %d hits, %d revalidations, %d misses\n",
+		stats.Hits, stats.Revalidations, stats.Misses)
+}
+
 func main() {
 	godotenv.Load()
 
+	nonInteractive := flag.Bool("non-interactive", false, "generate the standup summary to stdout without the TUI, e.g. for cron")
+	stats := flag.Bool("stats", false, "print httpcache hit/revalidation/miss counts to stderr when done")
+	flag.Parse()
+
 	config := LoadConfig()
 	if err := config.Validate(); err != nil {
 		log.Fatal(err)
 	}
 
-	gh := NewGithub(GitHubOptions{
-		APIKey:   config.GitHubToken,
-		Username: config.Username,
-	})
+	forges, err := NewForges(config.Forges, config.Concurrency)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	llm := NewLLMService(config.GeminiKey)
+	llm, err := NewLLM(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *nonInteractive {
+		runNonInteractive(forges, llm, config.DaysBack, config.PromptTemplatePath, *stats)
+		return
+	}
+
+	ctx := context.Background()
+	choices, err := forges.GetReposChoices(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	s := spinner.New()
 	s.Spinner = spinner.Dot
@@ -592,16 +548,17 @@ func main() {
 	p.PerPage = config.PerPage
 	p.ActiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "235", Dark: "252"}).Render("â€¢")
 	p.InactiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "250", Dark: "238"}).Render("â€¢")
-	p.SetTotalPages(len(gh.GetReposChoices()))
+	p.SetTotalPages(len(choices))
 
 	m := model{
-		choices:   gh.GetReposChoices(),
-		selected:  make(map[int]struct{}),
-		paginator: p,
-		spinner:   s,
-		gh:        gh,
-		llm:       llm,
-		daysBack:  config.DaysBack,
+		choices:            choices,
+		selected:           make(map[int]struct{}),
+		paginator:          p,
+		spinner:            s,
+		forges:             forges,
+		llm:                llm,
+		daysBack:           config.DaysBack,
+		promptTemplatePath: config.PromptTemplatePath,
 	}
 
 	t := tea.NewProgram(m)
@@ -609,4 +566,8 @@ func main() {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
 	}
+
+	if *stats {
+		printCacheStats(forges)
+	}
 }
@@ -1,39 +1,71 @@
 package main
 
-import "time"
+import (
+	"time"
 
-// Commit-related types
+	"github.com/RafaZeero/daily-go/internal/forge"
+)
+
+// Commit is a forge.Commit annotated with which forge it came from, so the
+// TUI and LLM providers can group activity across multiple configured forges.
 type Commit struct {
-	SHA      string    `json:"sha"`
-	Message  string    `json:"commit"`
-	Author   string    `json:"author"`
-	Date     time.Time `json:"date"`
-	RepoName string    `json:"repo_name"`
-	HTMLURL  string    `json:"html_url"`
+	SHA       string     `json:"sha"`
+	Message   string     `json:"commit"`
+	Author    string     `json:"author"`
+	Date      time.Time  `json:"date"`
+	RepoName  string     `json:"repo_name"`
+	HTMLURL   string     `json:"html_url"`
+	ForgeName forge.Kind `json:"forge_name"`
+	Additions int        `json:"additions"`
+	Deletions int        `json:"deletions"`
+	Files     []string   `json:"files"`
 }
 
-type CommitMessage struct {
-	Message string `json:"message"`
+// Issue is a forge.Issue annotated with which forge it came from.
+type Issue struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	Author    string     `json:"author"`
+	RepoName  string     `json:"repo_name"`
+	CreatedAt time.Time  `json:"created_at"`
+	ClosedAt  time.Time  `json:"closed_at"`
+	HTMLURL   string     `json:"html_url"`
+	ForgeName forge.Kind `json:"forge_name"`
 }
 
-type CommitAuthor struct {
-	Name  string `json:"name"`
-	Email string `json:"email"`
-	Date  string `json:"date"`
+// PullRequest is a forge.PullRequest annotated with which forge it came from.
+type PullRequest struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	Merged    bool       `json:"merged"`
+	Author    string     `json:"author"`
+	RepoName  string     `json:"repo_name"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	HTMLURL   string     `json:"html_url"`
+	ForgeName forge.Kind `json:"forge_name"`
 }
 
-type CommitDetails struct {
-	Message CommitMessage `json:"message"`
-	Author  CommitAuthor  `json:"author"`
+// Review is a forge.Review annotated with which forge it came from.
+type Review struct {
+	PRNumber    int        `json:"pr_number"`
+	RepoName    string     `json:"repo_name"`
+	State       string     `json:"state"`
+	SubmittedAt time.Time  `json:"submitted_at"`
+	HTMLURL     string     `json:"html_url"`
+	ForgeName   forge.Kind `json:"forge_name"`
 }
 
-type CommitResponse struct {
-	SHA     string        `json:"sha"`
-	Commit  CommitDetails `json:"commit"`
-	HTMLURL string        `json:"html_url"`
-	Author  *struct {
-		Login string `json:"login"`
-	} `json:"author"`
+// Activity bundles every stream GetActivitySince fetches for a selected set
+// of repos, so the TUI and LLM providers can work with one value instead of
+// four parallel slices.
+type Activity struct {
+	Commits      []Commit      `json:"commits"`
+	Issues       []Issue       `json:"issues"`
+	PullRequests []PullRequest `json:"pull_requests"`
+	Reviews      []Review      `json:"reviews"`
 }
 
 // Gemini API types